@@ -76,7 +76,8 @@ func Decode(s string) ([]byte, error) {
 	leadingZeros := 0
 	leadingZerosDone := false
 	bnText := make([]byte, 0, len(s))
-	for _, b := range s {
+	for i := 0; i < len(s); i++ {
+		b := s[i]
 		switch {
 		case !leadingZerosDone && b == '1':
 			leadingZeros++
@@ -20,10 +20,12 @@ limitations under the License.
 package base58_test
 
 import (
+	"bytes"
 	_ "embed"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/cinode/go-common/base58"
@@ -109,6 +111,16 @@ func TestErrorOnInvalidDecode(t *testing.T) {
 	}
 }
 
+func TestDecodeNonASCII(t *testing.T) {
+	for _, test := range []string{"ą", "3SEo3LWLoPntCą", "\xff\xfe"} {
+		t.Run(test, func(t *testing.T) {
+			decoded, err := base58.Decode(test)
+			require.Nil(t, decoded)
+			require.ErrorIs(t, err, base58.ErrInvalidBase58Character)
+		})
+	}
+}
+
 func FuzzEncodeDecode(f *testing.F) {
 	for _, test := range validTestCases(f) {
 		f.Add(test.data)
@@ -121,8 +133,56 @@ func FuzzEncodeDecode(f *testing.F) {
 		}
 
 		str := base58.Encode(a)
+		require.Equal(t, referenceEncode(a), str)
+		require.Equal(t, str, base58.EncodeConstantTime(a))
+
+		// Leading zero bytes map to leading '1' characters, one to one
+		zeros := len(a) - len(bytes.TrimLeft(a, "\x00"))
+		ones := len(str) - len(strings.TrimLeft(str, "1"))
+		require.Equal(t, zeros, ones)
+
 		back, err := base58.Decode(str)
 		require.NoError(t, err)
 		require.Equal(t, a, back)
+
+		back, err = base58.DecodeConstantTime(str)
+		require.NoError(t, err)
+		require.Equal(t, a, back)
+	})
+}
+
+func FuzzDecode(f *testing.F) {
+	for _, test := range validTestCases(f) {
+		f.Add(test.expected)
+	}
+	f.Add("3SEo3@LWLoPntC")
+	f.Add("0OIl")
+	f.Add("ą")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if len(s) > 512 {
+			// No point in testing those
+			t.SkipNow()
+		}
+
+		decoded, err := base58.Decode(s)
+
+		refDecoded, refErr := referenceDecode(s)
+		require.Equal(t, refErr == nil, err == nil)
+
+		ctDecoded, ctErr := base58.DecodeConstantTime(s)
+		require.Equal(t, ctErr == nil, err == nil)
+
+		if err != nil {
+			require.ErrorIs(t, err, base58.ErrInvalidBase58Character)
+			require.ErrorIs(t, ctErr, base58.ErrInvalidBase58Character)
+			return
+		}
+
+		require.Equal(t, refDecoded, decoded)
+		require.Equal(t, decoded, ctDecoded)
+
+		// Every valid base58 string is in its canonical form
+		require.Equal(t, s, base58.Encode(decoded))
 	})
 }
@@ -0,0 +1,133 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base58
+
+// Ranges of the bitcoin alphabet, base58 digits are mapped to consecutive characters
+// within each range.
+var ctAlphabetRanges = [...]struct{ lo, hi, digit int32 }{
+	{'1', '9', 0},
+	{'A', 'H', 9},
+	{'J', 'N', 17},
+	{'P', 'Z', 22},
+	{'a', 'k', 33},
+	{'m', 'z', 44},
+}
+
+// ctGE returns 1 if a >= b, 0 otherwise, both values must fit in 31 bits
+func ctGE(a, b int32) int32 { return 1 ^ int32(uint32(a-b)>>31) }
+
+func ctDigitToChar(d int32) byte {
+	c := '1' + d
+	for i := 1; i < len(ctAlphabetRanges); i++ {
+		r, prev := ctAlphabetRanges[i], ctAlphabetRanges[i-1]
+		c += ctGE(d, r.digit) * (r.lo - (prev.hi + 1))
+	}
+	return byte(c)
+}
+
+// ctCharToDigit returns the digit value and 1 if the character is valid, 0 otherwise
+func ctCharToDigit(c int32) (digit int32, valid int32) {
+	for _, r := range ctAlphabetRanges {
+		in := ctGE(c, r.lo) & ctGE(r.hi, c)
+		digit |= -in & (c - r.lo + r.digit)
+		valid |= in
+	}
+	return digit, valid
+}
+
+// EncodeConstantTime produces the same result as Encode but the computation
+// does not contain branches nor memory accesses depending on the encoded data.
+//
+// This function is meant to be used with secret data such as encryption keys.
+// Note that the length of the output still depends on the number of leading
+// zero bytes and the magnitude of the encoded value.
+func EncodeConstantTime(data []byte) string {
+	// Base58 digits in little-endian order, log(256)/log(58) < 1.38
+	digits := make([]int32, len(data)*138/100+1)
+	for _, b := range data {
+		carry := int32(b)
+		for j := range digits {
+			carry += digits[j] << 8
+			digits[j] = carry % 58
+			carry /= 58
+		}
+	}
+
+	leadingZeros, inPrefix := 0, int32(1)
+	for _, b := range data {
+		inPrefix &= 1 ^ ctGE(int32(b), 1)
+		leadingZeros += int(inPrefix)
+	}
+
+	significantDigits := 0
+	for j, d := range digits {
+		// The last non-zero digit determines the number of significant digits
+		nonZero := ctGE(d, 1)
+		significantDigits = int(int32(significantDigits)&(nonZero-1) | -nonZero&int32(j+1))
+	}
+
+	res := make([]byte, leadingZeros+significantDigits)
+	for i := range leadingZeros {
+		res[i] = '1'
+	}
+	for i := range significantDigits {
+		res[leadingZeros+i] = ctDigitToChar(digits[significantDigits-1-i])
+	}
+
+	return string(res)
+}
+
+// DecodeConstantTime produces the same result as Decode but the computation
+// does not contain branches nor memory accesses depending on the decoded data.
+//
+// This function is meant to be used with secret data such as encryption keys.
+// Contrary to Decode, the error does not contain the invalid character.
+func DecodeConstantTime(s string) ([]byte, error) {
+	// Decoded value in little-endian order, there can not be more bytes than characters
+	value := make([]int32, len(s))
+	leadingZeros, inPrefix, valid := 0, int32(1), int32(1)
+	for i := range len(s) {
+		digit, isValid := ctCharToDigit(int32(s[i]))
+		valid &= isValid
+
+		inPrefix &= 1 ^ ctGE(digit, 1)
+		leadingZeros += int(inPrefix)
+
+		carry := digit
+		for j := range value {
+			carry += value[j] * 58
+			value[j] = carry & 0xFF
+			carry >>= 8
+		}
+	}
+	if valid != 1 {
+		return nil, ErrInvalidBase58Character
+	}
+
+	significantBytes := 0
+	for j, b := range value {
+		nonZero := ctGE(b, 1)
+		significantBytes = int(int32(significantBytes)&(nonZero-1) | -nonZero&int32(j+1))
+	}
+
+	res := make([]byte, leadingZeros+significantBytes)
+	for i := range significantBytes {
+		res[leadingZeros+i] = byte(value[significantBytes-1-i])
+	}
+
+	return res, nil
+}
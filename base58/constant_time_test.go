@@ -0,0 +1,82 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base58_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/cinode/go-common/base58"
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+func TestConstantTimeEncodeDecode(t *testing.T) {
+	for _, test := range validTestCases(t) {
+		t.Run(fmt.Sprintf("data=%v", test.data), func(t *testing.T) {
+			encoded := base58.EncodeConstantTime(test.data)
+			require.Equal(t, test.expected, encoded)
+
+			decodedBack, err := base58.DecodeConstantTime(encoded)
+			require.NoError(t, err)
+			require.Equal(t, test.data, decodedBack)
+		})
+	}
+}
+
+func TestConstantTimeMatchesRegularCodec(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for i := range 1000 {
+		data := make([]byte, rnd.IntN(80))
+		for j := range data {
+			// Bias towards zeros to cover leading and trailing zero bytes
+			if rnd.IntN(4) != 0 {
+				data[j] = byte(rnd.Uint32())
+			}
+		}
+
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			encoded := base58.Encode(data)
+			require.Equal(t, encoded, base58.EncodeConstantTime(data))
+
+			decoded, err := base58.DecodeConstantTime(encoded)
+			require.NoError(t, err)
+			require.Equal(t, data, decoded)
+		})
+	}
+}
+
+func TestConstantTimeErrorOnInvalidDecode(t *testing.T) {
+	for _, test := range []string{
+		"@",
+		"0",
+		"O",
+		"I",
+		"l",
+		"3SEo3LWLoPntC@",
+		"@3SEo3LWLoPntC",
+		"3SEo3@LWLoPntC",
+		"11\xff",
+	} {
+		t.Run(test, func(t *testing.T) {
+			decoded, err := base58.DecodeConstantTime(test)
+			require.Nil(t, decoded)
+			require.ErrorIs(t, err, base58.ErrInvalidBase58Character)
+		})
+	}
+}
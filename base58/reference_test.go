@@ -0,0 +1,103 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base58_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+// Straightforward reference implementation of base58 following the algorithm
+// from the bitcoin codebase, used in differential tests only.
+
+const referenceAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var errReferenceInvalidCharacter = errors.New("invalid character")
+
+func referenceEncode(data []byte) string {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	// Big-endian base58 digits
+	digits := []byte{}
+	for _, b := range data[zeros:] {
+		carry := int(b)
+		for i := len(digits) - 1; i >= 0; i-- {
+			carry += int(digits[i]) * 256
+			digits[i] = byte(carry % 58)
+			carry /= 58
+		}
+		for carry > 0 {
+			digits = append([]byte{byte(carry % 58)}, digits...)
+			carry /= 58
+		}
+	}
+
+	sb := strings.Builder{}
+	for range zeros {
+		sb.WriteByte(referenceAlphabet[0])
+	}
+	for _, d := range digits {
+		sb.WriteByte(referenceAlphabet[d])
+	}
+	return sb.String()
+}
+
+func referenceDecode(s string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(s) && s[zeros] == referenceAlphabet[0] {
+		zeros++
+	}
+
+	// Big-endian bytes
+	value := []byte{}
+	for i := zeros; i < len(s); i++ {
+		carry := strings.IndexByte(referenceAlphabet, s[i])
+		if carry < 0 {
+			return nil, errReferenceInvalidCharacter
+		}
+		for j := len(value) - 1; j >= 0; j-- {
+			carry += int(value[j]) * 58
+			value[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			value = append([]byte{byte(carry)}, value...)
+			carry >>= 8
+		}
+	}
+
+	return append(make([]byte, zeros), value...), nil
+}
+
+func TestReferenceImplementation(t *testing.T) {
+	for _, test := range validTestCases(t) {
+		require.Equal(t, test.expected, referenceEncode(test.data))
+
+		decoded, err := referenceDecode(test.expected)
+		require.NoError(t, err)
+		require.Equal(t, test.data, decoded)
+	}
+
+	_, err := referenceDecode("3SEo3@LWLoPntC")
+	require.ErrorIs(t, err, errReferenceInvalidCharacter)
+}
@@ -0,0 +1,202 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base58
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// StreamChunkSize is the number of raw bytes encoded in a single chunk of the
+// streaming format.
+//
+// Base58 is not block-aligned thus the stream is split into independently
+// encoded chunks, each one terminated with StreamChunkSeparator. Only the last
+// chunk of the stream can be shorter than StreamChunkSize. The stream ends with
+// a StreamEndMarker line so that a stream truncated at a chunk boundary is detected.
+const StreamChunkSize = 64
+
+// StreamChunkSeparator terminates every chunk in the streaming format
+const StreamChunkSeparator = '\n'
+
+// StreamEndMarker is the last line of the stream, it is not a valid base58 text
+const StreamEndMarker = "="
+
+// Upper bound of the encoded chunk length, log(256)/log(58) < 1.38
+const streamMaxChunkLength = StreamChunkSize*138/100 + 1
+
+var (
+	ErrInvalidStreamChunk = errors.New("invalid base58 stream chunk")
+	ErrTruncatedStream    = errors.New("truncated base58 stream")
+	ErrEncoderClosed      = errors.New("base58 stream encoder is closed")
+)
+
+type encoder struct {
+	w   io.Writer
+	buf []byte
+	err error
+}
+
+// NewEncoder returns a stream encoder writing chunked base58 text to w.
+//
+// Data written to the encoder is buffered until a full chunk is available,
+// the caller must Close the encoder to flush the last partial chunk.
+func NewEncoder(w io.Writer) io.WriteCloser {
+	return &encoder{
+		w:   w,
+		buf: make([]byte, 0, StreamChunkSize),
+	}
+}
+
+func (e *encoder) Write(p []byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+
+	written := 0
+	for len(p) > 0 {
+		n := copy(e.buf[len(e.buf):StreamChunkSize], p)
+		e.buf = e.buf[:len(e.buf)+n]
+		p = p[n:]
+		written += n
+
+		if len(e.buf) == StreamChunkSize {
+			if err := e.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+func (e *encoder) flush() error {
+	chunk := make([]byte, 0, len(e.buf)*138/100+2)
+	chunk = append(chunk, Encode(e.buf)...)
+	chunk = append(chunk, StreamChunkSeparator)
+	e.buf = e.buf[:0]
+
+	if _, err := e.w.Write(chunk); err != nil {
+		e.err = err
+		return err
+	}
+	return nil
+}
+
+// Close flushes any buffered data and writes the end of stream marker,
+// it does not close the underlying writer
+func (e *encoder) Close() error {
+	if e.err != nil {
+		return e.err
+	}
+	if len(e.buf) > 0 {
+		if err := e.flush(); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(e.w, StreamEndMarker+string(StreamChunkSeparator)); err != nil {
+		e.err = err
+		return err
+	}
+	e.err = ErrEncoderClosed
+	return nil
+}
+
+type decoder struct {
+	r       *bufio.Reader
+	pending []byte
+	short   bool
+	err     error
+}
+
+// NewDecoder returns a stream decoder reading chunked base58 text produced by NewEncoder.
+//
+// Lines longer than the maximum encoded chunk length are rejected without buffering
+// them in full. A stream without the end marker results in ErrTruncatedStream.
+func NewDecoder(r io.Reader) io.Reader {
+	return &decoder{r: bufio.NewReaderSize(r, streamMaxChunkLength+1)}
+}
+
+func (d *decoder) Read(p []byte) (int, error) {
+	for len(d.pending) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		d.pending, d.err = d.nextChunk()
+	}
+
+	n := copy(p, d.pending)
+	d.pending = d.pending[n:]
+	return n, nil
+}
+
+func (d *decoder) nextChunk() ([]byte, error) {
+	line, err := d.readLine()
+	if err != nil {
+		return nil, err
+	}
+
+	if line == StreamEndMarker {
+		return nil, d.checkEnd()
+	}
+
+	if d.short {
+		return nil, fmt.Errorf("%w: data after the last chunk", ErrInvalidStreamChunk)
+	}
+
+	decoded, decodeErr := Decode(line)
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+	if len(decoded) == 0 || len(decoded) > StreamChunkSize {
+		return nil, fmt.Errorf("%w: invalid chunk length %d", ErrInvalidStreamChunk, len(decoded))
+	}
+	d.short = len(decoded) < StreamChunkSize
+
+	return decoded, nil
+}
+
+// readLine reads a single line without the separator, the length of the line
+// is limited so that the input without separators is not buffered in full
+func (d *decoder) readLine() (string, error) {
+	line, err := d.r.ReadSlice(StreamChunkSeparator)
+	switch {
+	case errors.Is(err, bufio.ErrBufferFull) || len(line) > streamMaxChunkLength+1:
+		return "", fmt.Errorf("%w: line too long", ErrInvalidStreamChunk)
+	case errors.Is(err, io.EOF) && len(line) == 0:
+		return "", ErrTruncatedStream
+	case err != nil && !errors.Is(err, io.EOF):
+		return "", err
+	}
+
+	return strings.TrimSuffix(string(line), string(StreamChunkSeparator)), nil
+}
+
+// checkEnd ensures there is no data after the end of stream marker
+func (d *decoder) checkEnd() error {
+	_, err := d.r.ReadByte()
+	switch {
+	case errors.Is(err, io.EOF):
+		return io.EOF
+	case err != nil:
+		return err
+	}
+	return fmt.Errorf("%w: data after the end of stream marker", ErrInvalidStreamChunk)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base58_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/cinode/go-common/base58"
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+func TestStreamEncodeDecode(t *testing.T) {
+	for _, size := range []int{
+		0, 1, 2,
+		base58.StreamChunkSize - 1,
+		base58.StreamChunkSize,
+		base58.StreamChunkSize + 1,
+		base58.StreamChunkSize*7 + 13,
+		100_000,
+	} {
+		t.Run(fmt.Sprintf("size=%d", size), func(t *testing.T) {
+			data := make([]byte, size)
+			for i := range data {
+				// Include long runs of zeros to check leading zeros in chunks
+				if (i/base58.StreamChunkSize)%3 != 0 {
+					data[i] = byte(i * 7)
+				}
+			}
+
+			encoded := bytes.Buffer{}
+			enc := base58.NewEncoder(&encoded)
+
+			// Write in uneven pieces to exercise buffering
+			for rest := data; len(rest) > 0; {
+				n := min(len(rest), 17)
+				written, err := enc.Write(rest[:n])
+				require.NoError(t, err)
+				require.Equal(t, n, written)
+				rest = rest[n:]
+			}
+			require.NoError(t, enc.Close())
+
+			_, err := enc.Write([]byte{1})
+			require.ErrorIs(t, err, base58.ErrEncoderClosed)
+
+			text, found := strings.CutSuffix(encoded.String(), base58.StreamEndMarker+"\n")
+			require.True(t, found)
+
+			for _, line := range strings.SplitAfter(text, "\n") {
+				if line == "" {
+					continue
+				}
+				require.LessOrEqual(t, len(line), base58.StreamChunkSize*138/100+2)
+				chunk, err := base58.Decode(strings.TrimSuffix(line, "\n"))
+				require.NoError(t, err)
+				require.Equal(t, data[:len(chunk)], chunk)
+				data = data[len(chunk):]
+			}
+			require.Empty(t, data)
+		})
+	}
+}
+
+func TestStreamDecodeRoundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte("The quick brown fox jumps over the lazy dog."), 1000)
+
+	encoded := bytes.Buffer{}
+	enc := base58.NewEncoder(&encoded)
+	_, err := enc.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, enc.Close())
+
+	decoded, err := io.ReadAll(base58.NewDecoder(iotest.OneByteReader(&encoded)))
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+}
+
+func TestStreamDecodeErrors(t *testing.T) {
+	fullChunk := base58.Encode(bytes.Repeat([]byte{0xFF}, base58.StreamChunkSize))
+	tooLongChunk := base58.Encode(bytes.Repeat([]byte{0xFF}, base58.StreamChunkSize+1))
+
+	for _, tc := range []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{"invalid character", "3SEo3@LWLoPntC\n", base58.ErrInvalidBase58Character},
+		{"empty chunk", fullChunk + "\n\n", base58.ErrInvalidStreamChunk},
+		{"too long chunk", tooLongChunk + "\n", base58.ErrInvalidStreamChunk},
+		{"data after short chunk", "2NEpo7TZRRrLZSi2U\n" + fullChunk + "\n=\n", base58.ErrInvalidStreamChunk},
+		{"no separators", strings.Repeat("2", 1_000_000), base58.ErrInvalidStreamChunk},
+		{"missing end marker", fullChunk + "\n", base58.ErrTruncatedStream},
+		{"missing end marker after short chunk", "2NEpo7TZRRrLZSi2U", base58.ErrTruncatedStream},
+		{"empty stream", "", base58.ErrTruncatedStream},
+		{"data after end marker", "2NEpo7TZRRrLZSi2U\n=\n2NEpo7TZRRrLZSi2U\n", base58.ErrInvalidStreamChunk},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := io.ReadAll(base58.NewDecoder(strings.NewReader(tc.input)))
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	t.Run("missing final separator", func(t *testing.T) {
+		decoded, err := io.ReadAll(base58.NewDecoder(strings.NewReader("2NEpo7TZRRrLZSi2U\n=")))
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello World!"), decoded)
+	})
+
+	t.Run("empty data", func(t *testing.T) {
+		decoded, err := io.ReadAll(base58.NewDecoder(strings.NewReader("=\n")))
+		require.NoError(t, err)
+		require.Empty(t, decoded)
+	})
+}
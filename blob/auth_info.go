@@ -17,16 +17,56 @@ limitations under the License.
 package blob
 
 import (
-	"bytes"
-	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/cinode/go-common/base58"
+	"github.com/cinode/go-common/cutl"
+)
+
+var (
+	ErrInvalidAuthInfo = errors.New("invalid auth info")
 )
 
 // AuthInfo is an opaque data that is necessary to perform update of an existing blob.
 //
 // Currently used only for dynamic links, auth info contains all the necessary information
-// to update the content of the blob. The representation is specific to the blob type
-type AuthInfo struct{ data []byte }
+// to update the content of the blob. The representation is specific to the blob type.
+// The content of auth info is redacted when formatted or logged.
+type AuthInfo struct{ secret }
+
+func AuthInfoFromBytes(ai []byte) *AuthInfo { return &AuthInfo{newSecret(ai)} }
+func (a *AuthInfo) Bytes() []byte           { return a.bytes() }
+func (a *AuthInfo) Equal(a2 *AuthInfo) bool {
+	return cutl.EqualPtrFunc(a, a2, func(a, a2 *AuthInfo) bool { return a.equal(&a2.secret) })
+}
+
+// IsZero reports whether auth info is nil or empty
+func (a *AuthInfo) IsZero() bool { return a == nil || a.isZero() }
+
+// IsValid reports whether auth info is not empty and was not destroyed
+func (a *AuthInfo) IsValid() bool { return a != nil && a.isValid() }
+
+// Destroy wipes auth info from memory, any further use of auth info panics
+func (a *AuthInfo) Destroy() { a.destroy() }
+
+func (a AuthInfo) String() string                { return a.redacted("AuthInfo") }
+func (a AuthInfo) GoString() string              { return "blob." + a.String() }
+func (a AuthInfo) Format(f fmt.State, verb rune) { formatRedacted(f, verb, a.String(), a.GoString()) }
+func (a AuthInfo) LogValue() slog.Value          { return slog.StringValue(a.String()) }
+
+// AuthInfoFromString decodes base58-encoded auth info in constant time
+func AuthInfoFromString(s string) (*AuthInfo, error) {
+	decoded, err := base58.DecodeConstantTime(s)
+	if err != nil || len(decoded) == 0 {
+		return nil, ErrInvalidAuthInfo
+	}
+	return &AuthInfo{secret{data: decoded}}, nil
+}
 
-func AuthInfoFromBytes(ai []byte) *AuthInfo { return &AuthInfo{data: bytes.Clone(ai)} }
-func (a *AuthInfo) Bytes() []byte           { return bytes.Clone(a.data) }
-func (a *AuthInfo) Equal(a2 *AuthInfo) bool { return subtle.ConstantTimeCompare(a.data, a2.data) == 1 }
+// Returns base58-encoded auth info, encoding is done in constant time
+func (a *AuthInfo) Base58() string {
+	a.checkNotDestroyed()
+	return base58.EncodeConstantTime(a.data)
+}
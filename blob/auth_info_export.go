@@ -0,0 +1,139 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	ErrInvalidAuthInfoExport          = errors.New("invalid auth info export")
+	ErrUnsupportedAuthInfoExport      = errors.New("unsupported auth info export version")
+	ErrAuthInfoExportDecryptionFailed = errors.New(
+		"auth info export decryption failed: invalid passphrase or corrupted data",
+	)
+)
+
+const (
+	// Version 1 of the export format uses PBKDF2-HMAC-SHA256
+	// to derive AES-256-GCM key from the passphrase
+	authInfoExportV1 = 0x01
+
+	authInfoExportSaltSize = 16
+)
+
+var (
+	// Number of PBKDF2 iterations used when exporting,
+	// the value follows the OWASP recommendation for PBKDF2-HMAC-SHA256
+	authInfoExportIterations uint32 = 600_000
+
+	// Limits of PBKDF2 iterations accepted when importing,
+	// protect against weak exports and excessive computation
+	authInfoExportMinIterations uint32 = 100_000
+	authInfoExportMaxIterations uint32 = 10_000_000
+)
+
+// Layout of the export (version 1):
+//
+//	version    - 1 byte
+//	iterations - 4 bytes, big endian
+//	salt       - 16 bytes
+//	nonce      - 12 bytes
+//	ciphertext - the rest, AES-256-GCM sealed auth info
+//
+// Everything before the ciphertext is used as the AEAD associated data.
+const authInfoExportV1HeaderSize = 1 + 4 + authInfoExportSaltSize + 12
+
+// Export encrypts auth info with a key derived from the passphrase.
+//
+// Random data is read from rnd, crypto/rand.Reader is used if rnd is nil.
+func (a *AuthInfo) Export(passphrase string, rnd io.Reader) ([]byte, error) {
+	a.checkNotDestroyed()
+
+	random, err := randomBytes(rnd, authInfoExportSaltSize+12)
+	if err != nil {
+		return nil, err
+	}
+
+	hdr := make([]byte, 0, authInfoExportV1HeaderSize)
+	hdr = append(hdr, authInfoExportV1)
+	hdr = binary.BigEndian.AppendUint32(hdr, authInfoExportIterations)
+	hdr = append(hdr, random...)
+
+	aead, err := authInfoExportAEAD(passphrase, hdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(hdr, hdr[authInfoExportV1HeaderSize-12:], a.data, hdr), nil
+}
+
+// ImportAuthInfo decrypts auth info exported with AuthInfo.Export
+func ImportAuthInfo(data []byte, passphrase string) (*AuthInfo, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: empty data", ErrInvalidAuthInfoExport)
+	}
+	if data[0] != authInfoExportV1 {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedAuthInfoExport, data[0])
+	}
+	if len(data) < authInfoExportV1HeaderSize {
+		return nil, fmt.Errorf("%w: truncated header", ErrInvalidAuthInfoExport)
+	}
+
+	hdr := data[:authInfoExportV1HeaderSize]
+
+	iterations := binary.BigEndian.Uint32(hdr[1:])
+	if iterations < authInfoExportMinIterations || iterations > authInfoExportMaxIterations {
+		return nil, fmt.Errorf("%w: invalid number of iterations: %d", ErrInvalidAuthInfoExport, iterations)
+	}
+
+	aead, err := authInfoExportAEAD(passphrase, hdr)
+	if err != nil {
+		return nil, err
+	}
+
+	ai, err := aead.Open(nil, hdr[authInfoExportV1HeaderSize-12:], data[authInfoExportV1HeaderSize:], hdr)
+	if err != nil {
+		return nil, ErrAuthInfoExportDecryptionFailed
+	}
+
+	return &AuthInfo{secret{data: ai}}, nil
+}
+
+func authInfoExportAEAD(passphrase string, hdr []byte) (cipher.AEAD, error) {
+	iterations := binary.BigEndian.Uint32(hdr[1:])
+	salt := hdr[5 : 5+authInfoExportSaltSize]
+
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, int(iterations), 32)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
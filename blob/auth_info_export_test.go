@@ -0,0 +1,117 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+func withFastAuthInfoExport(t *testing.T) {
+	iterations, minIterations := authInfoExportIterations, authInfoExportMinIterations
+	t.Cleanup(func() {
+		authInfoExportIterations, authInfoExportMinIterations = iterations, minIterations
+	})
+	authInfoExportIterations, authInfoExportMinIterations = 1000, 1000
+}
+
+func TestAuthInfoExportImport(t *testing.T) {
+	authInfo := AuthInfoFromBytes([]byte("auth info data"))
+
+	exported, err := authInfo.Export("secret passphrase", nil)
+	require.NoError(t, err)
+	require.Equal(t, authInfoExportIterations, binary.BigEndian.Uint32(exported[1:]))
+
+	imported, err := ImportAuthInfo(exported, "secret passphrase")
+	require.NoError(t, err)
+	require.True(t, authInfo.Equal(imported))
+
+	_, err = ImportAuthInfo(exported, "wrong passphrase")
+	require.ErrorIs(t, err, ErrAuthInfoExportDecryptionFailed)
+}
+
+func TestAuthInfoExportDeterministic(t *testing.T) {
+	withFastAuthInfoExport(t)
+
+	authInfo := AuthInfoFromBytes([]byte("auth info data"))
+	entropy := bytes.Repeat([]byte{0x42}, 28)
+
+	exported1, err := authInfo.Export("passphrase", bytes.NewReader(entropy))
+	require.NoError(t, err)
+	exported2, err := authInfo.Export("passphrase", bytes.NewReader(entropy))
+	require.NoError(t, err)
+	require.Equal(t, exported1, exported2)
+
+	exported3, err := authInfo.Export("passphrase", nil)
+	require.NoError(t, err)
+	require.NotEqual(t, exported1, exported3)
+
+	_, err = authInfo.Export("passphrase", bytes.NewReader(entropy[:10]))
+	require.NotNil(t, err)
+}
+
+func TestAuthInfoImportInvalid(t *testing.T) {
+	withFastAuthInfoExport(t)
+
+	authInfo := AuthInfoFromBytes([]byte("auth info data"))
+	exported, err := authInfo.Export("passphrase", nil)
+	require.NoError(t, err)
+
+	t.Run("tampered", func(t *testing.T) {
+		for i := range exported {
+			t.Run(fmt.Sprint(i), func(t *testing.T) {
+				tampered := bytes.Clone(exported)
+				tampered[i] ^= 0x01
+				_, err := ImportAuthInfo(tampered, "passphrase")
+				require.NotNil(t, err)
+			})
+		}
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		for i := range exported {
+			_, err := ImportAuthInfo(exported[:i], "passphrase")
+			require.NotNil(t, err)
+		}
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		data := bytes.Clone(exported)
+		data[0] = 0x02
+		_, err := ImportAuthInfo(data, "passphrase")
+		require.ErrorIs(t, err, ErrUnsupportedAuthInfoExport)
+	})
+
+	t.Run("invalid iterations", func(t *testing.T) {
+		for _, iterations := range []uint32{0, authInfoExportMinIterations - 1, authInfoExportMaxIterations + 1} {
+			data := bytes.Clone(exported)
+			binary.BigEndian.PutUint32(data[1:], iterations)
+			_, err := ImportAuthInfo(data, "passphrase")
+			require.ErrorIs(t, err, ErrInvalidAuthInfoExport)
+		}
+	})
+
+	t.Run("destroyed", func(t *testing.T) {
+		ai := AuthInfoFromBytes([]byte{1, 2, 3})
+		ai.Destroy()
+		require.Panics(t, func() { _, _ = ai.Export("passphrase", nil) })
+	})
+}
@@ -19,6 +19,7 @@ package blob
 import (
 	"testing"
 
+	"github.com/cinode/go-common/base58"
 	"github.com/cinode/go-common/picotestify/require"
 )
 
@@ -29,3 +30,17 @@ func TestAuthInfo(t *testing.T) {
 	require.True(t, authInfo.Equal(AuthInfoFromBytes(authInfoBytes)))
 	require.Nil(t, new(Key).Bytes())
 }
+
+func TestAuthInfoText(t *testing.T) {
+	authInfo := AuthInfoFromBytes([]byte{0, 0, 1, 2, 3})
+	require.Equal(t, base58.Encode(authInfo.Bytes()), authInfo.Base58())
+
+	authInfo2, err := AuthInfoFromString(authInfo.Base58())
+	require.NoError(t, err)
+	require.True(t, authInfo.Equal(authInfo2))
+
+	for _, s := range []string{"", "!@#"} {
+		_, err := AuthInfoFromString(s)
+		require.ErrorIs(t, err, ErrInvalidAuthInfo)
+	}
+}
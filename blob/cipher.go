@@ -0,0 +1,107 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	ErrUnknownCipher = errors.New("unknown cipher")
+)
+
+// Cipher identifies the encryption algorithm used with blob keys and IVs
+type Cipher struct {
+	id      byte
+	name    string
+	keySize int
+	ivSize  int
+}
+
+var (
+	CipherXChaCha20 = Cipher{id: 0x01, name: "XChaCha20", keySize: 32, ivSize: 24}
+	CipherAES256GCM = Cipher{id: 0x02, name: "AES-256-GCM", keySize: 32, ivSize: 12}
+)
+
+var ciphers = []Cipher{CipherXChaCha20, CipherAES256GCM}
+
+// CipherFromIDByte returns one of supported ciphers with given id
+func CipherFromIDByte(id byte) (Cipher, error) {
+	for _, c := range ciphers {
+		if c.id == id {
+			return c, nil
+		}
+	}
+	return Cipher{}, fmt.Errorf("%w: %d", ErrUnknownCipher, id)
+}
+
+func (c Cipher) IDByte() byte   { return c.id }
+func (c Cipher) KeySize() int   { return c.keySize }
+func (c Cipher) IVSize() int    { return c.ivSize }
+func (c Cipher) String() string { return c.name }
+
+func (c Cipher) validate() error {
+	if c.keySize == 0 {
+		return fmt.Errorf("%w: %d", ErrUnknownCipher, c.id)
+	}
+	return nil
+}
+
+func randomBytes(rnd io.Reader, size int) ([]byte, error) {
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+
+	ret := make([]byte, size)
+	if _, err := io.ReadFull(rnd, ret); err != nil {
+		return nil, fmt.Errorf("failed to read random data: %w", err)
+	}
+	return ret, nil
+}
+
+// GenerateKey creates a new random key for given cipher.
+//
+// Random data is read from rnd, crypto/rand.Reader is used if rnd is nil.
+func GenerateKey(c Cipher, rnd io.Reader) (*Key, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	key, err := randomBytes(rnd, c.keySize)
+	if err != nil {
+		return nil, err
+	}
+	return &Key{secret{data: key}}, nil
+}
+
+// GenerateIV creates a new random IV for given cipher.
+//
+// Random data is read from rnd, crypto/rand.Reader is used if rnd is nil.
+func GenerateIV(c Cipher, rnd io.Reader) (*IV, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	iv, err := randomBytes(rnd, c.ivSize)
+	if err != nil {
+		return nil, err
+	}
+	return &IV{secret{data: iv}}, nil
+}
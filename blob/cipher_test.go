@@ -0,0 +1,87 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+func TestCipher(t *testing.T) {
+	for _, c := range ciphers {
+		t.Run(c.String(), func(t *testing.T) {
+			c2, err := CipherFromIDByte(c.IDByte())
+			require.NoError(t, err)
+			require.Equal(t, c, c2)
+		})
+	}
+
+	require.Equal(t, 32, CipherXChaCha20.KeySize())
+	require.Equal(t, 24, CipherXChaCha20.IVSize())
+	require.Equal(t, 32, CipherAES256GCM.KeySize())
+	require.Equal(t, 12, CipherAES256GCM.IVSize())
+
+	_, err := CipherFromIDByte(0)
+	require.ErrorIs(t, err, ErrUnknownCipher)
+}
+
+func TestGenerateKeyAndIV(t *testing.T) {
+	for _, c := range ciphers {
+		t.Run(c.String(), func(t *testing.T) {
+			entropy := bytes.Repeat([]byte{0xAB}, c.KeySize()+c.IVSize())
+			rnd := bytes.NewReader(entropy)
+
+			key, err := GenerateKey(c, rnd)
+			require.NoError(t, err)
+			require.Equal(t, entropy[:c.KeySize()], key.Bytes())
+
+			iv, err := GenerateIV(c, rnd)
+			require.NoError(t, err)
+			require.Equal(t, entropy[c.KeySize():], iv.Bytes())
+
+			// Entropy source exhausted
+			_, err = GenerateKey(c, rnd)
+			require.ErrorIs(t, err, io.EOF)
+			_, err = GenerateIV(c, rnd)
+			require.ErrorIs(t, err, io.EOF)
+
+			// Default entropy source
+			key, err = GenerateKey(c, nil)
+			require.NoError(t, err)
+			require.Len(t, key.Bytes(), c.KeySize())
+
+			iv, err = GenerateIV(c, nil)
+			require.NoError(t, err)
+			require.Len(t, iv.Bytes(), c.IVSize())
+		})
+	}
+
+	t.Run("short entropy", func(t *testing.T) {
+		_, err := GenerateKey(CipherAES256GCM, bytes.NewReader([]byte{1, 2, 3}))
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+
+	t.Run("unknown cipher", func(t *testing.T) {
+		_, err := GenerateKey(Cipher{}, nil)
+		require.ErrorIs(t, err, ErrUnknownCipher)
+		_, err = GenerateIV(Cipher{}, nil)
+		require.ErrorIs(t, err, ErrUnknownCipher)
+	})
+}
@@ -0,0 +1,91 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"testing"
+
+	"github.com/cinode/go-common/cutl"
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+func testNilSafeEqual[T any, PT interface {
+	*T
+	Equal(PT) bool
+	IsZero() bool
+	IsValid() bool
+}](t *testing.T, value, other PT) {
+	var nilPtr PT
+	zero := PT(new(T))
+
+	require.True(t, value.Equal(value))
+	require.False(t, value.Equal(other))
+	require.False(t, value.Equal(nilPtr))
+	require.False(t, nilPtr.Equal(value))
+	require.True(t, nilPtr.Equal(nilPtr))
+
+	require.True(t, zero.Equal(PT(new(T))))
+	require.False(t, zero.Equal(value))
+	require.False(t, value.Equal(zero))
+	require.False(t, zero.Equal(nilPtr))
+	require.False(t, nilPtr.Equal(zero))
+
+	require.True(t, cutl.EqualPtr(value, value))
+	require.False(t, cutl.EqualPtr(value, other))
+	require.False(t, cutl.EqualPtr(value, nilPtr))
+	require.True(t, cutl.EqualPtr(nilPtr, nilPtr))
+
+	require.False(t, value.IsZero())
+	require.True(t, zero.IsZero())
+	require.True(t, nilPtr.IsZero())
+
+	require.True(t, value.IsValid())
+	require.False(t, zero.IsValid())
+	require.False(t, nilPtr.IsValid())
+}
+
+func TestNilSafeEqual(t *testing.T) {
+	t.Run("Key", func(t *testing.T) {
+		testNilSafeEqual(t, KeyFromBytes([]byte{1}), KeyFromBytes([]byte{2}))
+	})
+	t.Run("IV", func(t *testing.T) {
+		testNilSafeEqual(t, IVFromBytes([]byte{1}), IVFromBytes([]byte{2}))
+	})
+	t.Run("AuthInfo", func(t *testing.T) {
+		testNilSafeEqual(t, AuthInfoFromBytes([]byte{1}), AuthInfoFromBytes([]byte{2}))
+	})
+	t.Run("Name", func(t *testing.T) {
+		testNilSafeEqual(t, cutl.Must(NameFromBytes([]byte{1})), cutl.Must(NameFromBytes([]byte{2})))
+	})
+}
+
+func TestDestroyedIsNotValid(t *testing.T) {
+	key := KeyFromBytes([]byte{1, 2, 3})
+	key.Destroy()
+	require.True(t, key.IsZero())
+	require.False(t, key.IsValid())
+
+	iv := IVFromBytes([]byte{1, 2, 3})
+	iv.Destroy()
+	require.True(t, iv.IsZero())
+	require.False(t, iv.IsValid())
+
+	authInfo := AuthInfoFromBytes([]byte{1, 2, 3})
+	authInfo.Destroy()
+	require.True(t, authInfo.IsZero())
+	require.False(t, authInfo.IsValid())
+}
@@ -0,0 +1,122 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/cinode/go-common/base58"
+	"github.com/cinode/go-common/cutl"
+)
+
+var (
+	ErrInvalidFingerprintKey = errors.New("invalid fingerprint key")
+)
+
+const (
+	FingerprintKeyMinSize = 16
+
+	fingerprintSize   = 8
+	fingerprintDomain = "cinode fingerprint v1:"
+)
+
+// FingerprintKey is a secret key used to calculate fingerprints of secret data.
+//
+// Fingerprints are keyed so that those can not be used to test guesses of
+// low-entropy secrets by anyone who does not know the fingerprint key.
+// Services that need to compare fingerprints must share the same fingerprint key.
+type FingerprintKey struct{ secret }
+
+// FingerprintKeyFromBytes creates fingerprint key from a random secret
+// of at least FingerprintKeyMinSize bytes
+func FingerprintKeyFromBytes(key []byte) (*FingerprintKey, error) {
+	if len(key) < FingerprintKeyMinSize {
+		return nil, fmt.Errorf("%w: key must be at least %d bytes long", ErrInvalidFingerprintKey, FingerprintKeyMinSize)
+	}
+	return &FingerprintKey{newSecret(key)}, nil
+}
+
+// GenerateFingerprintKey creates a new random fingerprint key.
+//
+// Random data is read from rnd, crypto/rand.Reader is used if rnd is nil.
+func GenerateFingerprintKey(rnd io.Reader) (*FingerprintKey, error) {
+	key, err := randomBytes(rnd, 32)
+	if err != nil {
+		return nil, err
+	}
+	return &FingerprintKey{secret{data: key}}, nil
+}
+
+// Default fingerprint key is random, fingerprints can only be compared within a single process
+// unless the key is replaced with SetDefaultFingerprintKey.
+var defaultFingerprintKey atomic.Pointer[FingerprintKey]
+
+func init() {
+	defaultFingerprintKey.Store(cutl.Must(GenerateFingerprintKey(nil)))
+}
+
+// SetDefaultFingerprintKey changes the key used when no explicit fingerprint key
+// is given, including fingerprints shown in the redacted form of secrets
+func SetDefaultFingerprintKey(fk *FingerprintKey) {
+	cutl.PanicIf(fk == nil, ErrInvalidFingerprintKey)
+	defaultFingerprintKey.Store(fk)
+}
+
+// fingerprint returns base58-encoded truncated HMAC-SHA256 of the secret data,
+// the domain separates fingerprints of different kinds of secrets
+func (s *secret) fingerprint(fk *FingerprintKey, domain string) string {
+	s.checkNotDestroyed()
+	if fk == nil {
+		fk = defaultFingerprintKey.Load()
+	}
+	fk.checkNotDestroyed()
+
+	mac := hmac.New(sha256.New, fk.data)
+	mac.Write([]byte(fingerprintDomain))
+	mac.Write([]byte(domain))
+	mac.Write([]byte{0})
+	mac.Write(s.data)
+	return base58.Encode(mac.Sum(nil)[:fingerprintSize])
+}
+
+// Fingerprint returns a short identifier of the key that does not reveal the key itself,
+// default fingerprint key is used if fk is nil
+func (k *Key) Fingerprint(fk *FingerprintKey) string { return k.fingerprint(fk, "Key") }
+
+// Fingerprint returns a short identifier of the IV that does not reveal the IV itself,
+// default fingerprint key is used if fk is nil
+func (i *IV) Fingerprint(fk *FingerprintKey) string { return i.fingerprint(fk, "IV") }
+
+// Fingerprint returns a short identifier of auth info that does not reveal auth info itself,
+// default fingerprint key is used if fk is nil
+func (a *AuthInfo) Fingerprint(fk *FingerprintKey) string { return a.fingerprint(fk, "AuthInfo") }
+
+// Destroy wipes the fingerprint key from memory, any further use of the key panics
+func (fk *FingerprintKey) Destroy() { fk.destroy() }
+
+func (fk FingerprintKey) String() string   { return fk.redacted("FingerprintKey") }
+func (fk FingerprintKey) GoString() string { return "blob." + fk.String() }
+func (fk FingerprintKey) Format(f fmt.State, verb rune) {
+	formatRedacted(f, verb, fk.String(), fk.GoString())
+}
+func (fk FingerprintKey) LogValue() slog.Value { return slog.StringValue(fk.String()) }
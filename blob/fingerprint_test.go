@@ -0,0 +1,98 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cinode/go-common/base58"
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+func TestFingerprint(t *testing.T) {
+	fk1, err := FingerprintKeyFromBytes(bytes.Repeat([]byte{1}, FingerprintKeyMinSize))
+	require.NoError(t, err)
+	fk2, err := GenerateFingerprintKey(nil)
+	require.NoError(t, err)
+
+	data := []byte{1, 2, 3}
+	key, iv, authInfo := KeyFromBytes(data), IVFromBytes(data), AuthInfoFromBytes(data)
+
+	// Stable for the same secret and fingerprint key
+	require.Equal(t, key.Fingerprint(fk1), KeyFromBytes(data).Fingerprint(fk1))
+	require.Equal(t, key.Fingerprint(nil), KeyFromBytes(data).Fingerprint(nil))
+
+	// Test vector
+	require.Equal(t, "Y2eBwiU2Yyo", key.Fingerprint(fk1))
+
+	fingerprint, err := base58.Decode(key.Fingerprint(fk1))
+	require.NoError(t, err)
+	require.Len(t, fingerprint, fingerprintSize)
+
+	// Different secrets
+	require.NotEqual(t, key.Fingerprint(fk1), KeyFromBytes([]byte{1, 2, 4}).Fingerprint(fk1))
+
+	// Different fingerprint keys
+	require.NotEqual(t, key.Fingerprint(fk1), key.Fingerprint(fk2))
+
+	// Domain separation between kinds of secrets
+	require.NotEqual(t, key.Fingerprint(fk1), iv.Fingerprint(fk1))
+	require.NotEqual(t, key.Fingerprint(fk1), authInfo.Fingerprint(fk1))
+	require.NotEqual(t, iv.Fingerprint(fk1), authInfo.Fingerprint(fk1))
+
+	_, err = FingerprintKeyFromBytes(make([]byte, FingerprintKeyMinSize-1))
+	require.ErrorIs(t, err, ErrInvalidFingerprintKey)
+
+	_, err = GenerateFingerprintKey(bytes.NewReader(nil))
+	require.NotNil(t, err)
+
+	require.False(t, strings.Contains(fmt.Sprintf("%v %x", fk1, fk1), "01010101"))
+
+	key.Destroy()
+	require.Panics(t, func() { key.Fingerprint(fk1) })
+
+	fk2.Destroy()
+	require.Panics(t, func() { iv.Fingerprint(fk2) })
+}
+
+func TestDefaultFingerprintKey(t *testing.T) {
+	defaultKey := defaultFingerprintKey.Load()
+	t.Cleanup(func() { SetDefaultFingerprintKey(defaultKey) })
+
+	fk, err := GenerateFingerprintKey(nil)
+	require.NoError(t, err)
+
+	key := KeyFromBytes([]byte{1, 2, 3})
+	require.Equal(t, key.Fingerprint(defaultKey), key.Fingerprint(nil))
+
+	SetDefaultFingerprintKey(fk)
+	require.Equal(t, key.Fingerprint(fk), key.Fingerprint(nil))
+	require.Equal(t, "Key(redacted, fp="+key.Fingerprint(fk)+")", key.String())
+
+	require.Panics(t, func() { SetDefaultFingerprintKey(nil) })
+
+	// Redacted forms never panic, even if the default fingerprint key is destroyed
+	fk.Destroy()
+	require.Equal(t, "Key(redacted)", key.String())
+	require.Equal(t, "blob.Key(redacted)", fmt.Sprintf("%#v", key))
+	require.Equal(t, "Key(redacted)", key.LogValue().String())
+	require.Equal(t, "FingerprintKey(destroyed)", fk.String())
+	require.Panics(t, func() { key.Fingerprint(nil) })
+}
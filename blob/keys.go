@@ -17,20 +17,88 @@ limitations under the License.
 package blob
 
 import (
-	"bytes"
-	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/cinode/go-common/base58"
+	"github.com/cinode/go-common/cutl"
+)
+
+var (
+	ErrInvalidKey = errors.New("invalid key")
 )
 
 // Key with cipher type
-type Key struct{ key []byte }
+//
+// The content of the key is redacted when formatted or logged.
+// Formatting methods use value receivers so that the key is redacted
+// also when embedded by value in other structures.
+type Key struct{ secret }
+
+func KeyFromBytes(key []byte) *Key { return &Key{newSecret(key)} }
+func (k *Key) Bytes() []byte       { return k.bytes() }
+func (k *Key) Equal(k2 *Key) bool {
+	return cutl.EqualPtrFunc(k, k2, func(k, k2 *Key) bool { return k.equal(&k2.secret) })
+}
+
+// IsZero reports whether the key is nil or empty
+func (k *Key) IsZero() bool { return k == nil || k.isZero() }
+
+// IsValid reports whether the key is not empty and was not destroyed
+func (k *Key) IsValid() bool { return k != nil && k.isValid() }
+
+// Destroy wipes the key from memory, any further use of the key panics
+func (k *Key) Destroy() { k.destroy() }
 
-func KeyFromBytes(key []byte) *Key { return &Key{key: bytes.Clone(key)} }
-func (k *Key) Bytes() []byte       { return bytes.Clone(k.key) }
-func (k *Key) Equal(k2 *Key) bool  { return subtle.ConstantTimeCompare(k.key, k2.key) == 1 }
+func (k Key) String() string                { return k.redacted("Key") }
+func (k Key) GoString() string              { return "blob." + k.String() }
+func (k Key) Format(f fmt.State, verb rune) { formatRedacted(f, verb, k.String(), k.GoString()) }
+func (k Key) LogValue() slog.Value          { return slog.StringValue(k.String()) }
+
+// KeyFromString decodes key from either base58 or base32 text form
+func KeyFromString(s string) (*Key, error) {
+	decoded, err := decodeSecretText(s)
+	if err != nil || len(decoded) == 0 {
+		return nil, ErrInvalidKey
+	}
+	return &Key{secret{data: decoded}}, nil
+}
+
+// Returns base58-encoded key, encoding is done in constant time
+func (k *Key) Base58() string {
+	k.checkNotDestroyed()
+	return base58.EncodeConstantTime(k.data)
+}
+
+// Returns case-insensitive base32 text form of the key, encoding is done in constant time
+func (k *Key) Base32() string {
+	k.checkNotDestroyed()
+	return encodeBase32ConstantTime(k.data)
+}
 
 // IV
-type IV struct{ iv []byte }
+//
+// IV is not a secret by itself but is handled in the same way as Key
+// to avoid leaking it in logs along with the key.
+type IV struct{ secret }
+
+func IVFromBytes(iv []byte) *IV { return &IV{newSecret(iv)} }
+func (i *IV) Bytes() []byte     { return i.bytes() }
+func (i *IV) Equal(i2 *IV) bool {
+	return cutl.EqualPtrFunc(i, i2, func(i, i2 *IV) bool { return i.equal(&i2.secret) })
+}
+
+// IsZero reports whether the IV is nil or empty
+func (i *IV) IsZero() bool { return i == nil || i.isZero() }
+
+// IsValid reports whether the IV is not empty and was not destroyed
+func (i *IV) IsValid() bool { return i != nil && i.isValid() }
+
+// Destroy wipes the IV from memory, any further use of the IV panics
+func (i *IV) Destroy() { i.destroy() }
 
-func IVFromBytes(iv []byte) *IV { return &IV{iv: bytes.Clone(iv)} }
-func (i *IV) Bytes() []byte     { return bytes.Clone(i.iv) }
-func (i *IV) Equal(i2 *IV) bool { return subtle.ConstantTimeCompare(i.iv, i2.iv) == 1 }
+func (i IV) String() string                { return i.redacted("IV") }
+func (i IV) GoString() string              { return "blob." + i.String() }
+func (i IV) Format(f fmt.State, verb rune) { formatRedacted(f, verb, i.String(), i.GoString()) }
+func (i IV) LogValue() slog.Value          { return slog.StringValue(i.String()) }
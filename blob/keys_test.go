@@ -17,8 +17,12 @@ limitations under the License.
 package blob
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/cinode/go-common/base58"
+	"github.com/cinode/go-common/picotestify/assert"
 	"github.com/cinode/go-common/picotestify/require"
 )
 
@@ -30,6 +34,52 @@ func TestBlobKey(t *testing.T) {
 	require.Nil(t, new(Key).Bytes())
 }
 
+type capturingT struct{ messages []string }
+
+func (t *capturingT) Helper() {}
+func (t *capturingT) Error(msgAndArgs ...any) {
+	t.messages = append(t.messages, fmt.Sprint(msgAndArgs...))
+}
+
+func TestBlobKeyNotLeakedInDiff(t *testing.T) {
+	type withKey struct {
+		Name string
+		Key  *Key
+	}
+
+	ct := &capturingT{}
+	assert.Equal(ct,
+		withKey{Name: "a", Key: KeyFromBytes([]byte{0xAB, 0xAB, 0xAB, 0xAB})},
+		withKey{Name: "b", Key: KeyFromBytes([]byte{0xCD, 0xCD, 0xCD, 0xCD})},
+	)
+	require.Len(t, ct.messages, 1)
+	require.ContainsString(t, ct.messages[0], "Key(redacted")
+	require.NotContainsString(t, ct.messages[0], "ab ab")
+	require.NotContainsString(t, ct.messages[0], "cd cd")
+}
+
+func TestBlobKeyText(t *testing.T) {
+	key := KeyFromBytes([]byte{0, 1, 2, 3, 0xFF})
+
+	for _, s := range []string{
+		key.Base58(),
+		key.Base32(),
+		strings.ToUpper(key.Base32()),
+	} {
+		key2, err := KeyFromString(s)
+		require.NoError(t, err)
+		require.True(t, key.Equal(key2))
+	}
+
+	require.Equal(t, strings.ToLower(key.Base32()), key.Base32())
+	require.Equal(t, base58.Encode(key.Bytes()), key.Base58())
+
+	for _, s := range []string{"", "0", "!@#", "0!@#", "01"} {
+		_, err := KeyFromString(s)
+		require.ErrorIs(t, err, ErrInvalidKey)
+	}
+}
+
 func TestBlobIV(t *testing.T) {
 	ivBytes := []byte{1, 2, 3}
 	iv := IVFromBytes(ivBytes)
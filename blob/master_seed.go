@@ -0,0 +1,124 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+var (
+	ErrInvalidMasterSeed = errors.New("invalid master seed")
+)
+
+const (
+	MasterSeedMinSize = 32
+
+	childSeedSize = 32
+
+	masterSeedSalt  = "cinode master seed v1"
+	childSeedInfo   = "cinode child seed v1:"
+	keyMaterialInfo = "cinode key material v1:"
+)
+
+// MasterSeed allows deterministic derivation of many keys from a single secret.
+//
+// Seeds form a tree, a child seed is derived from its parent and a label using
+// HKDF-SHA256. Any seed in the tree can be used to derive key material, thus
+// backing up a single seed is enough to restore all keys below it.
+//
+// Auth infos are derived from the seed with signature.AuthInfoFromMasterSeed.
+type MasterSeed struct{ secret }
+
+// MasterSeedFromBytes creates master seed from a random secret
+// of at least MasterSeedMinSize bytes
+func MasterSeedFromBytes(seed []byte) (*MasterSeed, error) {
+	if len(seed) < MasterSeedMinSize {
+		return nil, fmt.Errorf("%w: seed must be at least %d bytes long", ErrInvalidMasterSeed, MasterSeedMinSize)
+	}
+	return &MasterSeed{newSecret(seed)}, nil
+}
+
+// Bytes returns the raw seed, this is the data that has to be backed up
+func (m *MasterSeed) Bytes() []byte { return m.bytes() }
+
+// GenerateMasterSeed creates a new random master seed.
+//
+// Random data is read from rnd, crypto/rand.Reader is used if rnd is nil.
+func GenerateMasterSeed(rnd io.Reader) (*MasterSeed, error) {
+	seed, err := randomBytes(rnd, MasterSeedMinSize)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(seed)
+
+	return MasterSeedFromBytes(seed)
+}
+
+func (m *MasterSeed) expand(info string, size int) []byte {
+	m.checkNotDestroyed()
+
+	prk, err := hkdf.Extract(sha256.New, m.data, []byte(masterSeedSalt))
+	if err != nil {
+		panic(err)
+	}
+	defer clear(prk)
+
+	ret, err := hkdf.Expand(sha256.New, prk, info, size)
+	if err != nil {
+		// Can only happen if the requested size is too large
+		panic(err)
+	}
+	return ret
+}
+
+// Child derives child seed for given label
+func (m *MasterSeed) Child(label string) *MasterSeed {
+	return &MasterSeed{secret{data: m.expand(childSeedInfo+label, childSeedSize)}}
+}
+
+// Path derives a descendant seed following labels in the path, calling
+// Path with no labels returns a copy of the seed
+func (m *MasterSeed) Path(labels ...string) *MasterSeed {
+	m.checkNotDestroyed()
+
+	ret := &MasterSeed{newSecret(m.data)}
+	for _, label := range labels {
+		ret = ret.Child(label)
+	}
+	return ret
+}
+
+// KeyMaterial derives size bytes of uniformly random key material for given purpose.
+//
+// Different purposes give independent key material, the caller should clear
+// the returned data once it is no longer needed.
+func (m *MasterSeed) KeyMaterial(purpose string, size int) []byte {
+	return m.expand(keyMaterialInfo+purpose, size)
+}
+
+// Destroy wipes the seed from memory, any further use of the seed panics
+func (m *MasterSeed) Destroy() { m.destroy() }
+
+func (m MasterSeed) String() string                { return m.redacted("MasterSeed") }
+func (m MasterSeed) GoString() string              { return "blob." + m.String() }
+func (m MasterSeed) Format(f fmt.State, verb rune) { formatRedacted(f, verb, m.String(), m.GoString()) }
+func (m MasterSeed) LogValue() slog.Value          { return slog.StringValue(m.String()) }
@@ -0,0 +1,105 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+// Test vectors generated with an independent implementation of HKDF-SHA256
+//
+//go:embed master_seed_test_vectors.json
+var masterSeedTestVectors []byte
+
+func TestMasterSeedTestVectors(t *testing.T) {
+	vectors := []struct {
+		Seed        string   `json:"seed"`
+		Path        []string `json:"path"`
+		PathSeed    string   `json:"pathSeed"`
+		Purpose     string   `json:"purpose"`
+		Size        int      `json:"size"`
+		KeyMaterial string   `json:"keyMaterial"`
+	}{}
+	require.NoError(t, json.Unmarshal(masterSeedTestVectors, &vectors))
+	require.NotEmpty(t, vectors)
+
+	for _, v := range vectors {
+		t.Run(fmt.Sprintf("%s/%q/%s", v.Seed[:8], v.Path, v.Purpose), func(t *testing.T) {
+			seedBytes, err := hex.DecodeString(v.Seed)
+			require.NoError(t, err)
+
+			seed, err := MasterSeedFromBytes(seedBytes)
+			require.NoError(t, err)
+
+			pathSeed := seed.Path(v.Path...)
+			require.Equal(t, v.PathSeed, hex.EncodeToString(pathSeed.Bytes()))
+
+			keyMaterial := pathSeed.KeyMaterial(v.Purpose, v.Size)
+			require.Equal(t, v.KeyMaterial, hex.EncodeToString(keyMaterial))
+
+			// Path is equivalent to a chain of Child calls
+			chained := seed
+			for _, label := range v.Path {
+				chained = chained.Child(label)
+			}
+			require.Equal(t, pathSeed.Bytes(), chained.Bytes())
+
+			// Restoring the seed from the backup gives the same results
+			restored, err := MasterSeedFromBytes(pathSeed.Bytes())
+			require.NoError(t, err)
+			require.Equal(t, keyMaterial, restored.KeyMaterial(v.Purpose, v.Size))
+		})
+	}
+}
+
+func TestMasterSeed(t *testing.T) {
+	_, err := MasterSeedFromBytes(make([]byte, MasterSeedMinSize-1))
+	require.ErrorIs(t, err, ErrInvalidMasterSeed)
+
+	entropy := bytes.Repeat([]byte{0x11}, MasterSeedMinSize)
+	seed, err := GenerateMasterSeed(bytes.NewReader(entropy))
+	require.NoError(t, err)
+	require.Equal(t, entropy, seed.Bytes())
+
+	_, err = GenerateMasterSeed(bytes.NewReader(entropy[:5]))
+	require.NotNil(t, err)
+
+	seed2, err := GenerateMasterSeed(nil)
+	require.NoError(t, err)
+	require.NotEqual(t, seed.KeyMaterial("p", 32), seed2.KeyMaterial("p", 32))
+
+	// Labels and purposes are domain-separated
+	require.NotEqual(t, seed.Child("a").KeyMaterial("p", 32), seed.Child("b").KeyMaterial("p", 32))
+	require.NotEqual(t, seed.KeyMaterial("p1", 32), seed.KeyMaterial("p2", 32))
+	require.NotEqual(t, seed.Path("a", "b").KeyMaterial("p", 32), seed.Child("a/b").KeyMaterial("p", 32))
+	require.NotEqual(t, seed.Child("a").Bytes(), seed.KeyMaterial("a", 32))
+
+	require.Regexp(t, `^MasterSeed\(redacted, fp=\w+\)$`, fmt.Sprint(seed))
+
+	seed.Destroy()
+	require.Panics(t, func() { seed.Child("a") })
+	require.Panics(t, func() { seed.Path() })
+	require.Panics(t, func() { seed.KeyMaterial("p", 32) })
+	require.Panics(t, func() { seed.Bytes() })
+}
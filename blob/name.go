@@ -22,6 +22,8 @@ import (
 	"errors"
 
 	"github.com/cinode/go-common/base58"
+	"github.com/cinode/go-common/cutl"
+	"github.com/cinode/go-common/multibase"
 )
 
 var (
@@ -58,9 +60,23 @@ func NameFromHashAndType(hash []byte, t Type) (*Name, error) {
 	return &Name{bn: bn}, nil
 }
 
-// NameFromString decodes base58-encoded string into blob name
+// NameFromString decodes base58 or base32 text form (see Base32) into blob name
+//
+// Multibase-prefixed strings are not accepted. Multibase prefixes are valid base58
+// characters thus such strings can not be told apart from plain base58 names,
+// use NameFromMultibase for them instead.
 func NameFromString(s string) (*Name, error) {
-	decoded, err := base58.Decode(s)
+	decoded, err := decodeText(s)
+	if err != nil {
+		return nil, ErrInvalidBlobName
+	}
+	return NameFromBytes(decoded)
+}
+
+// NameFromMultibase decodes multibase-prefixed string into blob name,
+// this is the only function accepting names produced by Multibase
+func NameFromMultibase(s string) (*Name, error) {
+	_, decoded, err := multibase.Decode(s)
 	if err != nil {
 		return nil, ErrInvalidBlobName
 	}
@@ -79,6 +95,17 @@ func (b *Name) String() string {
 	return base58.Encode(b.bn)
 }
 
+// Returns case-insensitive base32 text form of the blob name,
+// suitable for DNS labels and case-insensitive filesystems
+func (b *Name) Base32() string {
+	return encodeBase32(b.bn)
+}
+
+// Returns multibase-encoded blob name
+func (b *Name) Multibase(e multibase.Encoding) (string, error) {
+	return multibase.Encode(e, b.bn)
+}
+
 // Extracts hash from blob name
 func (b *Name) Hash() []byte {
 	return b.bn[1:]
@@ -97,6 +124,19 @@ func (b *Name) Bytes() []byte {
 	return bytes.Clone(b.bn)
 }
 
+// Equal compares blob names, nil name is only equal to other nil name
 func (b *Name) Equal(b2 *Name) bool {
-	return subtle.ConstantTimeCompare(b.bn, b2.bn) == 1
+	return cutl.EqualPtrFunc(b, b2, func(b, b2 *Name) bool {
+		return subtle.ConstantTimeCompare(b.bn, b2.bn) == 1
+	})
+}
+
+// IsZero reports whether the name is nil or empty
+func (b *Name) IsZero() bool {
+	return b == nil || len(b.bn) == 0
+}
+
+// IsValid reports whether the name has correct length
+func (b *Name) IsValid() bool {
+	return b != nil && len(b.bn) > 0 && len(b.bn) <= 0x7F
 }
@@ -19,8 +19,11 @@ package blob
 import (
 	"crypto/sha256"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/cinode/go-common/base58"
+	"github.com/cinode/go-common/multibase"
 	"github.com/cinode/go-common/picotestify/assert"
 	"github.com/cinode/go-common/picotestify/require"
 )
@@ -54,10 +57,33 @@ func TestBlobName(t *testing.T) {
 				require.True(t, bn.Equal(bn2))
 
 				b := bn.Bytes()
+				require.NotSame(t, &bn.bn[0], &b[0])
 				bn3, err := NameFromBytes(b)
 				require.NoError(t, err)
 				require.Equal(t, bn, bn3)
 				require.True(t, bn.Equal(bn3))
+
+				bn5, err := NameFromString(bn.Base32())
+				require.NoError(t, err)
+				require.True(t, bn.Equal(bn5))
+
+				bn6, err := NameFromString(strings.ToUpper(bn.Base32()))
+				require.NoError(t, err)
+				require.True(t, bn.Equal(bn6))
+
+				for _, e := range []multibase.Encoding{
+					multibase.Base58BTC,
+					multibase.Base32,
+					multibase.Base64URL,
+				} {
+					mb, err := bn.Multibase(e)
+					require.NoError(t, err)
+					require.Equal(t, byte(e), mb[0])
+
+					bn4, err := NameFromMultibase(mb)
+					require.NoError(t, err)
+					require.True(t, bn.Equal(bn4))
+				}
 			})
 		}
 	}
@@ -68,6 +94,70 @@ func TestBlobName(t *testing.T) {
 	_, err = NameFromString("")
 	require.ErrorIs(t, err, ErrInvalidBlobName)
 
+	_, err = NameFromMultibase("z")
+	require.ErrorIs(t, err, ErrInvalidBlobName)
+
+	_, err = NameFromMultibase("f0102")
+	require.ErrorIs(t, err, ErrInvalidBlobName)
+
+	_, err = NameFromMultibase("Qmabc")
+	require.ErrorIs(t, err, ErrInvalidBlobName)
+
+	_, err = NameFromString("0!@#")
+	require.ErrorIs(t, err, ErrInvalidBlobName)
+
+	bn, err := NameFromBytes([]byte{0xFF, 0xFF, 0xFF})
+	require.NoError(t, err)
+
+	_, err = bn.Multibase('f')
+	require.ErrorIs(t, err, multibase.ErrUnsupportedEncoding)
+
+	// Multibase prefix is not interpreted by NameFromString
+	_, err = NameFromString("u____")
+	require.ErrorIs(t, err, ErrInvalidBlobName)
+
 	_, err = NameFromHashAndType(nil, Type{t: 0x00})
 	require.ErrorIs(t, err, ErrInvalidBlobName)
 }
+
+func TestBlobNameBase32DNSLabel(t *testing.T) {
+	bn, err := NameFromHashAndType(sha256.New().Sum(nil), Type{t: 0x01})
+	require.NoError(t, err)
+
+	b32 := bn.Base32()
+	require.Len(t, b32, 54)
+	require.Regexp(t, `^[a-z0-9]{1,63}$`, b32)
+}
+
+func TestBlobNameMultibaseNotParsedAsString(t *testing.T) {
+	for i := range 1000 {
+		hash := sha256.Sum256([]byte{byte(i), byte(i >> 8)})
+		bn, err := NameFromHashAndType(hash[:], Type{t: byte(i)})
+		require.NoError(t, err)
+
+		for _, e := range []multibase.Encoding{
+			multibase.Base58BTC,
+			multibase.Base32,
+			multibase.Base64URL,
+		} {
+			mb, err := bn.Multibase(e)
+			require.NoError(t, err)
+
+			bn2, err := NameFromMultibase(mb)
+			require.NoError(t, err)
+			require.True(t, bn.Equal(bn2))
+
+			// The string is either rejected or decoded as plain base58 including the prefix,
+			// it is never silently treated as multibase
+			bn3, err := NameFromString(mb)
+			if err != nil {
+				require.ErrorIs(t, err, ErrInvalidBlobName)
+				continue
+			}
+			decoded, err := base58.Decode(mb)
+			require.NoError(t, err)
+			require.Equal(t, decoded, bn3.Bytes())
+			require.False(t, bn.Equal(bn3))
+		}
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	ErrSecretDestroyed = errors.New("secret data was destroyed")
+)
+
+// secret holds sensitive data that must not leak through formatting
+// and that can be explicitly wiped from memory.
+//
+// Any access to the data after it was destroyed panics with ErrSecretDestroyed.
+type secret struct {
+	data      []byte
+	destroyed bool
+}
+
+func newSecret(data []byte) secret { return secret{data: bytes.Clone(data)} }
+
+func (s *secret) checkNotDestroyed() {
+	if s.destroyed {
+		panic(ErrSecretDestroyed)
+	}
+}
+
+func (s *secret) bytes() []byte {
+	s.checkNotDestroyed()
+	return bytes.Clone(s.data)
+}
+
+func (s *secret) equal(s2 *secret) bool {
+	s.checkNotDestroyed()
+	s2.checkNotDestroyed()
+	return subtle.ConstantTimeCompare(s.data, s2.data) == 1
+}
+
+// isZero reports whether secret holds no data, destroyed secret is also zero
+func (s *secret) isZero() bool { return len(s.data) == 0 }
+
+func (s *secret) isValid() bool { return !s.destroyed && len(s.data) > 0 }
+
+func (s *secret) destroy() {
+	clear(s.data)
+	s.data = nil
+	s.destroyed = true
+}
+
+// redacted returns the text form of the secret that contains
+// the fingerprint of the secret instead of its content, the fingerprint
+// is omitted if the default fingerprint key was destroyed
+func (s *secret) redacted(typeName string) string {
+	if s.destroyed {
+		return typeName + "(destroyed)"
+	}
+	fk := defaultFingerprintKey.Load()
+	if fk.destroyed {
+		return typeName + "(redacted)"
+	}
+	return typeName + "(redacted, fp=" + s.fingerprint(fk, typeName) + ")"
+}
+
+// formatRedacted is used to implement fmt.Formatter,
+// the redacted form is used for all verbs
+func formatRedacted(f fmt.State, verb rune, str, goStr string) {
+	if verb == 'v' && f.Flag('#') {
+		str = goStr
+	}
+	_, _ = io.WriteString(f, str)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+func TestSecretRedaction(t *testing.T) {
+	secretBytes := []byte{0xDE, 0xAD, 0xBE, 0xEF}
+	leaks := []string{"222", "173", "deadbeef", "DEADBEEF", "\xde\xad\xbe\xef"}
+
+	key := KeyFromBytes(secretBytes)
+	iv := IVFromBytes(secretBytes)
+	authInfo := AuthInfoFromBytes(secretBytes)
+
+	keyStr := "Key(redacted, fp=" + key.Fingerprint(nil) + ")"
+	ivStr := "IV(redacted, fp=" + iv.Fingerprint(nil) + ")"
+	authInfoStr := "AuthInfo(redacted, fp=" + authInfo.Fingerprint(nil) + ")"
+
+	for _, tc := range []struct {
+		name     string
+		obj      any
+		str      string
+		goString string
+	}{
+		{"Key", key, keyStr, "blob." + keyStr},
+		{"IV", iv, ivStr, "blob." + ivStr},
+		{"AuthInfo", authInfo, authInfoStr, "blob." + authInfoStr},
+		{"Key value", *key, keyStr, "blob." + keyStr},
+		{"IV value", *iv, ivStr, "blob." + ivStr},
+		{"AuthInfo value", *authInfo, authInfoStr, "blob." + authInfoStr},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, format := range []string{"%v", "%+v", "%s", "%x", "%X", "%d", "%q"} {
+				require.Equal(t, tc.str, fmt.Sprintf(format, tc.obj))
+			}
+			require.Equal(t, tc.goString, fmt.Sprintf("%#v", tc.obj))
+			require.Equal(t, tc.str, fmt.Sprint(tc.obj))
+
+			// Secrets embedded in other structures
+			for _, format := range []string{"%v", "%+v", "%#v", "%x"} {
+				str := fmt.Sprintf(format, struct{ Secret any }{tc.obj})
+				for _, leak := range leaks {
+					require.False(t, strings.Contains(str, leak), str)
+				}
+			}
+
+			buf := bytes.Buffer{}
+			slog.New(slog.NewJSONHandler(&buf, nil)).Info("test", "secret", tc.obj)
+			require.True(t, strings.Contains(buf.String(), `"secret":"`+tc.str+`"`), buf.String())
+		})
+	}
+
+	// Content is not modified by formatting
+	require.Equal(t, secretBytes, key.Bytes())
+	require.Equal(t, secretBytes, iv.Bytes())
+	require.Equal(t, secretBytes, authInfo.Bytes())
+}
+
+func TestSecretDestroy(t *testing.T) {
+	secretBytes := []byte{1, 2, 3}
+
+	key := KeyFromBytes(secretBytes)
+	iv := IVFromBytes(secretBytes)
+	authInfo := AuthInfoFromBytes(secretBytes)
+
+	internalKeyData := key.data
+	key.Destroy()
+	iv.Destroy()
+	authInfo.Destroy()
+
+	// Internal buffer is wiped
+	require.Equal(t, []byte{0, 0, 0}, internalKeyData)
+
+	require.Equal(t, "Key(destroyed)", key.String())
+	require.Equal(t, "blob.IV(destroyed)", fmt.Sprintf("%#v", iv))
+	require.Equal(t, "AuthInfo(destroyed)", fmt.Sprint(authInfo))
+
+	for name, f := range map[string]func(){
+		"Key.Bytes":       func() { key.Bytes() },
+		"Key.Equal":       func() { key.Equal(KeyFromBytes(secretBytes)) },
+		"Key.Equal arg":   func() { KeyFromBytes(secretBytes).Equal(key) },
+		"Key.Base58":      func() { key.Base58() },
+		"Key.Base32":      func() { key.Base32() },
+		"IV.Bytes":        func() { iv.Bytes() },
+		"IV.Equal":        func() { iv.Equal(IVFromBytes(secretBytes)) },
+		"AuthInfo.Bytes":  func() { authInfo.Bytes() },
+		"AuthInfo.Equal":  func() { authInfo.Equal(AuthInfoFromBytes(secretBytes)) },
+		"AuthInfo.Base58": func() { authInfo.Base58() },
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Panics(t, f)
+		})
+	}
+
+	// Destroying twice is allowed
+	require.NotPanics(t, key.Destroy)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"encoding/base32"
+	"errors"
+	"strings"
+
+	"github.com/cinode/go-common/base58"
+)
+
+// Base32 text form is meant for case-insensitive contexts such as DNS labels
+// or file names on case-insensitive filesystems. It is the lowercase RFC 4648
+// base32 encoding without padding, prefixed with the '0' character. The prefix
+// is neither part of the base58 nor the base32 alphabet thus both forms can be
+// told apart when parsing.
+//
+// The base32 form of a SHA-256 based blob name is 54 characters long
+// which fits within the 63 characters limit of a DNS label.
+const base32Prefix = "0"
+
+var base32Encoding = base32.
+	NewEncoding("abcdefghijklmnopqrstuvwxyz234567").
+	WithPadding(base32.NoPadding)
+
+var errInvalidBase32 = errors.New("invalid base32 text")
+
+func encodeBase32(data []byte) string {
+	return base32Prefix + base32Encoding.EncodeToString(data)
+}
+
+// isValidBase32Length checks if the length of unpadded base32 text can be produced
+// by the encoder, 1, 3 or 6 trailing characters can not encode full bytes
+func isValidBase32Length(n int) bool {
+	switch n % 8 {
+	case 1, 3, 6:
+		return false
+	default:
+		return true
+	}
+}
+
+// decodeBase32 decodes base32 text without the prefix, upper case letters are accepted
+func decodeBase32(s string) ([]byte, error) {
+	if !isValidBase32Length(len(s)) {
+		return nil, errInvalidBase32
+	}
+	return base32Encoding.DecodeString(strings.ToLower(s))
+}
+
+// decodeText decodes either the base58 or the base32 text form
+func decodeText(s string) ([]byte, error) {
+	if b32, isBase32 := strings.CutPrefix(s, base32Prefix); isBase32 {
+		return decodeBase32(b32)
+	}
+	return base58.Decode(s)
+}
+
+// decodeSecretText is a variant of decodeText used for secret data,
+// both forms are decoded in constant time
+func decodeSecretText(s string) ([]byte, error) {
+	if b32, isBase32 := strings.CutPrefix(s, base32Prefix); isBase32 {
+		return decodeBase32ConstantTime(b32)
+	}
+	return base58.DecodeConstantTime(s)
+}
+
+// Ranges of the base32 alphabet, both lower and upper case letters are accepted when decoding
+var ctBase32Ranges = [...]struct{ lo, hi, digit int32 }{
+	{'a', 'z', 0},
+	{'A', 'Z', 0},
+	{'2', '7', 26},
+}
+
+// ctGE returns 1 if a >= b, 0 otherwise, both values must fit in 31 bits
+func ctGE(a, b int32) int32 { return 1 ^ int32(uint32(a-b)>>31) }
+
+func ctBase32DigitToChar(d int32) byte {
+	return byte('a' + d + ctGE(d, 26)*('2'-'a'-26))
+}
+
+// ctBase32CharToDigit returns the digit value and 1 if the character is valid, 0 otherwise
+func ctBase32CharToDigit(c int32) (digit int32, valid int32) {
+	for _, r := range ctBase32Ranges {
+		in := ctGE(c, r.lo) & ctGE(r.hi, c)
+		digit |= -in & (c - r.lo + r.digit)
+		valid |= in
+	}
+	return digit, valid
+}
+
+// encodeBase32ConstantTime produces the same result as encodeBase32 but the computation
+// does not contain branches nor memory accesses depending on the encoded data
+func encodeBase32ConstantTime(data []byte) string {
+	res := make([]byte, 0, len(base32Prefix)+(len(data)*8+4)/5)
+	res = append(res, base32Prefix...)
+
+	buf, bits := uint32(0), 0
+	for _, b := range data {
+		buf = buf<<8 | uint32(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			res = append(res, ctBase32DigitToChar(int32(buf>>bits)&31))
+		}
+		buf &= 1<<bits - 1
+	}
+	if bits > 0 {
+		res = append(res, ctBase32DigitToChar(int32(buf<<(5-bits))&31))
+	}
+
+	return string(res)
+}
+
+// decodeBase32ConstantTime accepts the same input as decodeBase32 and gives the same result
+// but the computation does not contain branches nor memory accesses depending on the decoded data.
+// Only the length of the text, which is not secret, is checked with branches.
+//
+// Same as encoding/base32, trailing bits that do not form a full byte are ignored.
+// Contrary to encoding/base32, new line characters are not skipped.
+func decodeBase32ConstantTime(s string) ([]byte, error) {
+	if !isValidBase32Length(len(s)) {
+		return nil, errInvalidBase32
+	}
+
+	res := make([]byte, 0, len(s)*5/8)
+	buf, bits, valid := uint32(0), 0, int32(1)
+	for i := range len(s) {
+		digit, isValid := ctBase32CharToDigit(int32(s[i]))
+		valid &= isValid
+
+		buf = buf<<5 | uint32(digit)
+		bits += 5
+		if bits >= 8 {
+			bits -= 8
+			res = append(res, byte(buf>>bits))
+		}
+		buf &= 1<<bits - 1
+	}
+	if valid != 1 {
+		return nil, errInvalidBase32
+	}
+
+	return res, nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blob
+
+import (
+	"encoding/base32"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+func TestBase32ConstantTimeMatchesStandardEncoding(t *testing.T) {
+	std := base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for size := range 100 {
+		data := make([]byte, size)
+		for i := range data {
+			data[i] = byte(rnd.Uint32())
+		}
+
+		encoded := encodeBase32ConstantTime(data)
+		require.Equal(t, base32Prefix+std.EncodeToString(data), encoded)
+		require.Equal(t, encodeBase32(data), encoded)
+
+		b32 := strings.TrimPrefix(encoded, base32Prefix)
+		decoded, err := decodeBase32ConstantTime(b32)
+		require.NoError(t, err)
+		require.Equal(t, data, decoded)
+
+		decoded, err = decodeBase32ConstantTime(strings.ToUpper(b32))
+		require.NoError(t, err)
+		require.Equal(t, data, decoded)
+	}
+}
+
+func TestBase32ConstantTimeDecodeErrors(t *testing.T) {
+	for _, s := range []string{
+		"ab1d",
+		"ab8d",
+		"ab=d",
+		"ab d",
+		"ab{d",
+		"ab@d",
+		"ab\x00d",
+		"ab\xffd",
+	} {
+		_, regularErr := decodeBase32(s)
+		require.Error(t, regularErr)
+
+		_, err := decodeBase32ConstantTime(s)
+		require.ErrorIs(t, err, errInvalidBase32)
+	}
+
+	// All characters are checked against the regular decoder, new lines
+	// are skipped by encoding/base32 but are rejected in key text
+	for c := range 256 {
+		if c == '\r' || c == '\n' {
+			continue
+		}
+		for _, prefix := range []string{"", "a", "abcdefg"} {
+			s := prefix + string([]byte{byte(c)})
+			expected, regularErr := decodeBase32(s)
+
+			decoded, err := decodeBase32ConstantTime(s)
+			if regularErr != nil {
+				require.Error(t, err)
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, expected, decoded)
+		}
+	}
+
+	_, err := decodeBase32ConstantTime("ab\ncd")
+	require.ErrorIs(t, err, errInvalidBase32)
+}
+
+func TestConstantTimeMatchesRegularCodec(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(3, 4))
+
+	// Texts of all lengths, including the ones that can not be produced by the encoder
+	for length := range 33 {
+		for range 20 {
+			text := make([]byte, length)
+			for i := range text {
+				text[i] = "abcdefghijklmnopqrstuvwxyz234567ABZ"[rnd.IntN(35)]
+			}
+
+			expected, regularErr := decodeBase32(string(text))
+			decoded, err := decodeBase32ConstantTime(string(text))
+			require.Equal(t, regularErr, err)
+			require.Equal(t, expected, decoded)
+			require.Equal(t, isValidBase32Length(length), err == nil)
+
+			if err == nil {
+				reencoded := base32Encoding.EncodeToString(decoded)
+				require.Equal(t, reencoded, encodeBase32ConstantTime(decoded)[len(base32Prefix):])
+			}
+		}
+	}
+
+	for _, s := range []string{"0abc", "0a", "0abcdef"} {
+		_, err := NameFromString(s)
+		require.ErrorIs(t, err, ErrInvalidBlobName)
+
+		_, err = KeyFromString(s)
+		require.ErrorIs(t, err, ErrInvalidKey)
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cutl
+
+// EqualPtrFunc compares two pointers with given function,
+// nil pointers are only equal to other nil pointers
+func EqualPtrFunc[T any](a, b *T, eq func(a, b *T) bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return eq(a, b)
+}
+
+// EqualPtr compares two pointers using the Equal method,
+// nil pointers are only equal to other nil pointers
+func EqualPtr[T any, PT interface {
+	*T
+	Equal(PT) bool
+}](a, b PT) bool {
+	return EqualPtrFunc(a, b, func(a, b *T) bool { return PT(a).Equal(b) })
+}
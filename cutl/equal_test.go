@@ -0,0 +1,50 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cutl_test
+
+import (
+	"testing"
+
+	"github.com/cinode/go-common/cutl"
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+type equalTester struct{ v int }
+
+func (e *equalTester) Equal(e2 *equalTester) bool { return e.v == e2.v }
+
+func TestEqualPtr(t *testing.T) {
+	a1, a2, b := &equalTester{v: 1}, &equalTester{v: 1}, &equalTester{v: 2}
+
+	require.True(t, cutl.EqualPtr(a1, a2))
+	require.True(t, cutl.EqualPtr(a1, a1))
+	require.False(t, cutl.EqualPtr(a1, b))
+	require.False(t, cutl.EqualPtr(a1, nil))
+	require.False(t, cutl.EqualPtr(nil, a1))
+	require.True(t, cutl.EqualPtr[equalTester](nil, nil))
+}
+
+func TestEqualPtrFunc(t *testing.T) {
+	eq := func(a, b *int) bool { return *a == *b }
+	v1, v2, v3 := 1, 1, 2
+
+	require.True(t, cutl.EqualPtrFunc(&v1, &v2, eq))
+	require.False(t, cutl.EqualPtrFunc(&v1, &v3, eq))
+	require.False(t, cutl.EqualPtrFunc(&v1, nil, eq))
+	require.False(t, cutl.EqualPtrFunc(nil, &v1, eq))
+	require.True(t, cutl.EqualPtrFunc(nil, nil, eq))
+}
@@ -29,7 +29,7 @@ func TestPanicIf(t *testing.T) {
 		cutl.PanicIf(false, "no panic")
 	})
 
-	require.Panics(t, func() {
+	require.PanicsWithValue(t, "panic", func() {
 		cutl.PanicIf(true, "panic")
 	})
 }
@@ -39,7 +39,7 @@ func TestPanicIfError(t *testing.T) {
 		cutl.PanicIfError(nil)
 	})
 
-	require.Panics(t, func() {
+	require.PanicsWithError(t, "error", func() {
 		cutl.PanicIfError(errors.New("error"))
 	})
 }
@@ -49,8 +49,9 @@ func TestMust(t *testing.T) {
 		require.Equal(t, 1234, cutl.Must(1234, nil))
 	})
 
-	require.Panics(t, func() {
-		require.Equal(t, 1234, cutl.Must(1234, errors.New("error")))
+	err := errors.New("error")
+	require.PanicsWithValue(t, err, func() {
+		require.Equal(t, 1234, cutl.Must(1234, err))
 	})
 }
 
@@ -62,8 +63,9 @@ func TestMust2(t *testing.T) {
 		require.Equal(t, 5678, v2)
 	})
 
-	require.Panics(t, func() {
-		v1, v2 := cutl.Must2(1234, 5678, errors.New("error"))
+	err := errors.New("error")
+	require.PanicsWithValue(t, err, func() {
+		v1, v2 := cutl.Must2(1234, 5678, err)
 		require.Equal(t, 1234, v1)
 		require.Equal(t, 5678, v2)
 	})
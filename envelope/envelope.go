@@ -0,0 +1,388 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/cinode/go-common/blob"
+)
+
+var (
+	ErrInvalidEnvelope      = errors.New("invalid blob envelope")
+	ErrUnsupportedVersion   = errors.New("unsupported blob envelope version")
+	ErrUnsupportedCipher    = errors.New("unsupported blob envelope cipher")
+	ErrInvalidConfig        = errors.New("invalid blob envelope configuration")
+	ErrTruncated            = errors.New("blob envelope is truncated")
+	ErrAuthenticationFailed = errors.New("blob envelope authentication failed")
+	ErrWriterClosed         = errors.New("blob envelope writer is closed")
+)
+
+const (
+	version = 0x01
+
+	DefaultChunkSize = 64 * 1024
+	MaxChunkSize     = 16 * 1024 * 1024
+)
+
+// Layout of the envelope (version 1):
+//
+//	version    - 1 byte
+//	cipher     - 1 byte, cipher ID
+//	chunk size - 4 bytes, big endian
+//	iv         - IV of the cipher
+//	chunks     - sequence of AEAD-sealed chunks
+//
+// Each chunk contains chunk size bytes of plaintext, except the last one that
+// can be shorter (and is empty only if the whole plaintext is empty).
+// Chunks are sealed using the STREAM construction - nonce of a chunk is the IV
+// with its last 5 bytes XOR-ed with the chunk counter (4 bytes, big endian)
+// and the last chunk flag (1 byte). This way reordering, truncation or
+// extension of the chunk sequence is detected.
+//
+// Associated data of each chunk is the envelope header followed by the blob
+// type byte, binding the ciphertext to the format version, parameters and the
+// type of the blob.
+const headerFixedSize = 1 + 1 + 4
+
+// Config contains parameters of the envelope
+type Config struct {
+	Type      blob.Type
+	Cipher    blob.Cipher
+	Key       *blob.Key
+	IV        *blob.IV
+	ChunkSize int // DefaultChunkSize is used if zero
+}
+
+type chunkAEAD struct {
+	aead    cipher.AEAD
+	iv      []byte
+	ad      []byte
+	counter uint64
+	nonce   []byte
+}
+
+func newChunkAEAD(c blob.Cipher, key *blob.Key, iv []byte, ad []byte) (*chunkAEAD, error) {
+	if c != blob.CipherAES256GCM {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedCipher, c)
+	}
+
+	keyBytes := key.Bytes()
+	defer clear(keyBytes)
+	if len(keyBytes) != c.KeySize() {
+		return nil, fmt.Errorf("%w: invalid key size", ErrInvalidConfig)
+	}
+	if len(iv) != c.IVSize() {
+		return nil, fmt.Errorf("%w: invalid iv size", ErrInvalidConfig)
+	}
+
+	block, err := aes.NewCipher(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chunkAEAD{
+		aead:  aead,
+		iv:    iv,
+		ad:    ad,
+		nonce: make([]byte, len(iv)),
+	}, nil
+}
+
+func (c *chunkAEAD) nextNonce(last bool) ([]byte, error) {
+	if c.counter > math.MaxUint32 {
+		return nil, fmt.Errorf("%w: too many chunks", ErrInvalidEnvelope)
+	}
+
+	suffix := binary.BigEndian.AppendUint32(nil, uint32(c.counter))
+	if last {
+		suffix = append(suffix, 1)
+	} else {
+		suffix = append(suffix, 0)
+	}
+
+	copy(c.nonce, c.iv)
+	offset := len(c.nonce) - len(suffix)
+	for i, b := range suffix {
+		c.nonce[offset+i] ^= b
+	}
+
+	c.counter++
+	return c.nonce, nil
+}
+
+func header(c blob.Cipher, chunkSize int, iv []byte) []byte {
+	hdr := []byte{version, c.IDByte()}
+	hdr = binary.BigEndian.AppendUint32(hdr, uint32(chunkSize))
+	return append(hdr, iv...)
+}
+
+func associatedData(hdr []byte, t blob.Type) []byte {
+	return append(append([]byte{}, hdr...), t.IDByte())
+}
+
+type writer struct {
+	w         io.Writer
+	aead      *chunkAEAD
+	chunkSize int
+	buf       []byte
+	out       []byte
+	hdr       []byte
+	err       error
+}
+
+// NewWriter returns a writer that encrypts data written to it into the envelope
+// written to w. The writer must be closed to finalize the envelope, closing
+// the writer does not close w.
+//
+// The same key and IV pair must never be used to encrypt different data.
+func NewWriter(w io.Writer, cfg Config) (io.WriteCloser, error) {
+	chunkSize := cfg.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = DefaultChunkSize
+	}
+	if chunkSize < 0 || chunkSize > MaxChunkSize {
+		return nil, fmt.Errorf("%w: invalid chunk size %d", ErrInvalidConfig, chunkSize)
+	}
+	if cfg.Key == nil || cfg.IV == nil {
+		return nil, fmt.Errorf("%w: missing key or iv", ErrInvalidConfig)
+	}
+
+	iv := cfg.IV.Bytes()
+	hdr := header(cfg.Cipher, chunkSize, iv)
+
+	aead, err := newChunkAEAD(cfg.Cipher, cfg.Key, iv, associatedData(hdr, cfg.Type))
+	if err != nil {
+		return nil, err
+	}
+
+	return &writer{
+		w:         w,
+		aead:      aead,
+		chunkSize: chunkSize,
+		buf:       make([]byte, 0, chunkSize+1),
+		hdr:       hdr,
+	}, nil
+}
+
+func (w *writer) writeHeader() error {
+	if w.hdr == nil {
+		return nil
+	}
+	if _, err := w.w.Write(w.hdr); err != nil {
+		return err
+	}
+	w.hdr = nil
+	return nil
+}
+
+func (w *writer) sealChunk(plaintext []byte, last bool) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	nonce, err := w.aead.nextNonce(last)
+	if err != nil {
+		return err
+	}
+
+	w.out = w.aead.aead.Seal(w.out[:0], nonce, plaintext, w.aead.ad)
+	_, err = w.w.Write(w.out)
+	return err
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	written := 0
+	for len(p) > 0 {
+		// One extra byte is kept in the buffer, a full chunk is sealed only
+		// once it is known that it is not the last one
+		n := copy(w.buf[len(w.buf):cap(w.buf)], p)
+		w.buf = w.buf[:len(w.buf)+n]
+		p = p[n:]
+		written += n
+
+		if len(w.buf) == cap(w.buf) {
+			if err := w.sealChunk(w.buf[:w.chunkSize], false); err != nil {
+				w.err = err
+				return written, err
+			}
+			w.buf[0] = w.buf[w.chunkSize]
+			w.buf = w.buf[:1]
+		}
+	}
+
+	return written, nil
+}
+
+func (w *writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+
+	err := w.sealChunk(w.buf, true)
+	clear(w.buf)
+	if err != nil {
+		w.err = err
+		return err
+	}
+
+	w.err = ErrWriterClosed
+	return nil
+}
+
+type reader struct {
+	r         io.Reader
+	aead      *chunkAEAD
+	chunkSize int
+	buf       []byte
+	pending   []byte
+	done      bool
+	err       error
+}
+
+// NewReader returns a reader that decrypts the envelope read from r.
+//
+// The envelope header is read and validated before returning. The type must
+// match the one used when creating the envelope. Data returned from the reader
+// is authenticated, an error is returned if the envelope was tampered with
+// including truncation, reordering or removal of chunks.
+func NewReader(r io.Reader, t blob.Type, key *blob.Key) (io.Reader, error) {
+	if key == nil {
+		return nil, fmt.Errorf("%w: missing key", ErrInvalidConfig)
+	}
+
+	hdr := make([]byte, headerFixedSize)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, truncatedOnEOF(err)
+	}
+
+	if hdr[0] != version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, hdr[0])
+	}
+
+	c, err := blob.CipherFromIDByte(hdr[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedCipher, err)
+	}
+
+	chunkSize := binary.BigEndian.Uint32(hdr[2:])
+	if chunkSize == 0 || chunkSize > MaxChunkSize {
+		return nil, fmt.Errorf("%w: invalid chunk size %d", ErrInvalidEnvelope, chunkSize)
+	}
+
+	iv := make([]byte, c.IVSize())
+	if _, err := io.ReadFull(r, iv); err != nil {
+		return nil, truncatedOnEOF(err)
+	}
+	hdr = append(hdr, iv...)
+
+	aead, err := newChunkAEAD(c, key, iv, associatedData(hdr, t))
+	if err != nil {
+		return nil, err
+	}
+
+	// One extra byte is read to detect whether the chunk is the last one
+	sealedChunkSize := int(chunkSize) + aead.aead.Overhead()
+	return &reader{
+		r:         r,
+		aead:      aead,
+		chunkSize: sealedChunkSize,
+		buf:       make([]byte, 0, sealedChunkSize+1),
+	}, nil
+}
+
+func truncatedOnEOF(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return ErrTruncated
+	}
+	return err
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.done {
+			r.err = io.EOF
+			continue
+		}
+		r.err = r.openChunk()
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (r *reader) openChunk() error {
+	// Buffer may already contain one byte of the current chunk from the previous read
+	n, err := io.ReadFull(r.r, r.buf[len(r.buf):cap(r.buf)])
+	r.buf = r.buf[:len(r.buf)+n]
+
+	last := false
+	switch {
+	case err == nil:
+	case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+		last = true
+	default:
+		return err
+	}
+
+	sealed := r.buf
+	if !last {
+		sealed = r.buf[:r.chunkSize]
+	}
+	if len(sealed) < r.aead.aead.Overhead() {
+		return ErrTruncated
+	}
+
+	nonce, err := r.aead.nextNonce(last)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := r.aead.aead.Open(nil, nonce, sealed, r.aead.ad)
+	if err != nil {
+		return ErrAuthenticationFailed
+	}
+
+	if last {
+		r.done = true
+		r.buf = r.buf[:0]
+	} else {
+		r.buf[0] = r.buf[r.chunkSize]
+		r.buf = r.buf[:1]
+	}
+
+	r.pending = plaintext
+	return nil
+}
@@ -0,0 +1,277 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envelope_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/cinode/go-common/blob"
+	"github.com/cinode/go-common/blobtypes"
+	"github.com/cinode/go-common/cutl"
+	"github.com/cinode/go-common/envelope"
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+const testChunkSize = 16
+
+// Size of the header for AES-256-GCM
+const testHeaderSize = 1 + 1 + 4 + 12
+
+func testConfig() envelope.Config {
+	return envelope.Config{
+		Type:      blobtypes.Static,
+		Cipher:    blob.CipherAES256GCM,
+		Key:       cutl.Must(blob.GenerateKey(blob.CipherAES256GCM, nil)),
+		IV:        cutl.Must(blob.GenerateIV(blob.CipherAES256GCM, nil)),
+		ChunkSize: testChunkSize,
+	}
+}
+
+func testData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i * 31)
+	}
+	return data
+}
+
+func seal(t *testing.T, cfg envelope.Config, data []byte) []byte {
+	buf := bytes.Buffer{}
+	w, err := envelope.NewWriter(&buf, cfg)
+	require.NoError(t, err)
+
+	// Write in uneven pieces to exercise buffering
+	for rest := data; len(rest) > 0; {
+		n := min(len(rest), 7)
+		written, err := w.Write(rest[:n])
+		require.NoError(t, err)
+		require.Equal(t, n, written)
+		rest = rest[n:]
+	}
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func open(cfg envelope.Config, sealed []byte) ([]byte, error) {
+	r, err := envelope.NewReader(bytes.NewReader(sealed), cfg.Type, cfg.Key)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, size := range []int{
+		0, 1,
+		testChunkSize - 1, testChunkSize, testChunkSize + 1,
+		testChunkSize * 5, testChunkSize*5 + 3,
+	} {
+		t.Run(fmt.Sprint(size), func(t *testing.T) {
+			cfg := testConfig()
+			data := testData(size)
+
+			sealed := seal(t, cfg, data)
+
+			chunks := max(1, (size+testChunkSize-1)/testChunkSize)
+			require.Len(t, sealed, testHeaderSize+size+chunks*16)
+
+			opened, err := open(cfg, sealed)
+			require.NoError(t, err)
+			require.Equal(t, data, opened)
+
+			r, err := envelope.NewReader(iotest.OneByteReader(bytes.NewReader(sealed)), cfg.Type, cfg.Key)
+			require.NoError(t, err)
+			opened, err = io.ReadAll(iotest.OneByteReader(r))
+			require.NoError(t, err)
+			require.Equal(t, data, opened)
+		})
+	}
+
+	t.Run("default chunk size", func(t *testing.T) {
+		cfg := testConfig()
+		cfg.ChunkSize = 0
+		data := testData(envelope.DefaultChunkSize*2 + 100)
+
+		opened, err := open(cfg, seal(t, cfg, data))
+		require.NoError(t, err)
+		require.Equal(t, data, opened)
+	})
+}
+
+func TestBindsBlobType(t *testing.T) {
+	cfg := testConfig()
+	sealed := seal(t, cfg, testData(100))
+
+	cfg.Type = blobtypes.DynamicLink
+	_, err := open(cfg, sealed)
+	require.ErrorIs(t, err, envelope.ErrAuthenticationFailed)
+}
+
+func TestWrongKey(t *testing.T) {
+	cfg := testConfig()
+	sealed := seal(t, cfg, testData(100))
+
+	cfg.Key = cutl.Must(blob.GenerateKey(blob.CipherAES256GCM, nil))
+	_, err := open(cfg, sealed)
+	require.ErrorIs(t, err, envelope.ErrAuthenticationFailed)
+}
+
+func TestTampering(t *testing.T) {
+	cfg := testConfig()
+	sealed := seal(t, cfg, testData(testChunkSize*3+5))
+
+	t.Run("modified bytes", func(t *testing.T) {
+		for i := range sealed {
+			tampered := bytes.Clone(sealed)
+			tampered[i] ^= 0x01
+			_, err := open(cfg, tampered)
+			require.NotNil(t, err, "byte %d", i)
+		}
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		for i := range sealed {
+			_, err := open(cfg, sealed[:i])
+			require.NotNil(t, err, "length %d", i)
+		}
+	})
+
+	sealedChunk := testChunkSize + 16
+	chunk := func(i int) []byte {
+		return sealed[testHeaderSize+i*sealedChunk : min(len(sealed), testHeaderSize+(i+1)*sealedChunk)]
+	}
+
+	t.Run("reordered chunks", func(t *testing.T) {
+		reordered := append([]byte{}, sealed[:testHeaderSize]...)
+		reordered = append(reordered, chunk(1)...)
+		reordered = append(reordered, chunk(0)...)
+		reordered = append(reordered, chunk(2)...)
+		reordered = append(reordered, chunk(3)...)
+		require.Len(t, reordered, len(sealed))
+
+		_, err := open(cfg, reordered)
+		require.ErrorIs(t, err, envelope.ErrAuthenticationFailed)
+	})
+
+	t.Run("removed chunk", func(t *testing.T) {
+		removed := append([]byte{}, sealed[:testHeaderSize]...)
+		removed = append(removed, chunk(0)...)
+		removed = append(removed, chunk(2)...)
+		removed = append(removed, chunk(3)...)
+
+		_, err := open(cfg, removed)
+		require.ErrorIs(t, err, envelope.ErrAuthenticationFailed)
+	})
+
+	t.Run("removed last chunk", func(t *testing.T) {
+		_, err := open(cfg, sealed[:testHeaderSize+3*sealedChunk])
+		require.ErrorIs(t, err, envelope.ErrAuthenticationFailed)
+	})
+
+	t.Run("extended", func(t *testing.T) {
+		_, err := open(cfg, append(bytes.Clone(sealed), chunk(3)...))
+		require.ErrorIs(t, err, envelope.ErrAuthenticationFailed)
+	})
+}
+
+func TestInvalidHeader(t *testing.T) {
+	cfg := testConfig()
+	sealed := seal(t, cfg, testData(10))
+
+	for _, tc := range []struct {
+		name   string
+		modify func(b []byte) []byte
+		err    error
+	}{
+		{"version", func(b []byte) []byte { b[0] = 0x02; return b }, envelope.ErrUnsupportedVersion},
+		{"unknown cipher", func(b []byte) []byte { b[1] = 0x00; return b }, envelope.ErrUnsupportedCipher},
+		{
+			"unsupported cipher",
+			func(b []byte) []byte { b[1] = blob.CipherXChaCha20.IDByte(); return b },
+			envelope.ErrUnsupportedCipher,
+		},
+		{
+			"zero chunk size",
+			func(b []byte) []byte { copy(b[2:], []byte{0, 0, 0, 0}); return b },
+			envelope.ErrInvalidEnvelope,
+		},
+		{
+			"huge chunk size",
+			func(b []byte) []byte { copy(b[2:], []byte{0xFF, 0, 0, 0}); return b },
+			envelope.ErrInvalidEnvelope,
+		},
+		{"chunk size", func(b []byte) []byte { b[5]++; return b }, envelope.ErrAuthenticationFailed},
+		{"no header", func(b []byte) []byte { return b[:0] }, envelope.ErrTruncated},
+		{"truncated header", func(b []byte) []byte { return b[:4] }, envelope.ErrTruncated},
+		{"truncated iv", func(b []byte) []byte { return b[:testHeaderSize-1] }, envelope.ErrTruncated},
+		{"no chunks", func(b []byte) []byte { return b[:testHeaderSize] }, envelope.ErrTruncated},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := open(cfg, tc.modify(bytes.Clone(sealed)))
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestInvalidConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		modify func(cfg *envelope.Config)
+		err    error
+	}{
+		{
+			"unsupported cipher",
+			func(cfg *envelope.Config) { cfg.Cipher = blob.CipherXChaCha20 },
+			envelope.ErrUnsupportedCipher,
+		},
+		{"negative chunk size", func(cfg *envelope.Config) { cfg.ChunkSize = -1 }, envelope.ErrInvalidConfig},
+		{
+			"huge chunk size",
+			func(cfg *envelope.Config) { cfg.ChunkSize = envelope.MaxChunkSize + 1 },
+			envelope.ErrInvalidConfig,
+		},
+		{"missing key", func(cfg *envelope.Config) { cfg.Key = nil }, envelope.ErrInvalidConfig},
+		{"missing iv", func(cfg *envelope.Config) { cfg.IV = nil }, envelope.ErrInvalidConfig},
+		{"invalid key", func(cfg *envelope.Config) { cfg.Key = blob.KeyFromBytes([]byte{1}) }, envelope.ErrInvalidConfig},
+		{"invalid iv", func(cfg *envelope.Config) { cfg.IV = blob.IVFromBytes([]byte{1}) }, envelope.ErrInvalidConfig},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := testConfig()
+			tc.modify(&cfg)
+			_, err := envelope.NewWriter(io.Discard, cfg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	_, err := envelope.NewReader(bytes.NewReader(nil), blobtypes.Static, nil)
+	require.ErrorIs(t, err, envelope.ErrInvalidConfig)
+}
+
+func TestWriterClosed(t *testing.T) {
+	w, err := envelope.NewWriter(io.Discard, testConfig())
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	_, err = w.Write([]byte{1})
+	require.ErrorIs(t, err, envelope.ErrWriterClosed)
+	require.ErrorIs(t, w.Close(), envelope.ErrWriterClosed)
+}
@@ -0,0 +1,229 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keybundle
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cinode/go-common/blob"
+)
+
+var (
+	ErrInvalidBundle      = errors.New("invalid key bundle")
+	ErrUnsupportedVersion = errors.New("unsupported key bundle version")
+	ErrUnsupportedScheme  = errors.New("unsupported key bundle scheme")
+	ErrInvalidRecipient   = errors.New("invalid key bundle recipient")
+	ErrDecryptionFailed   = errors.New("key bundle decryption failed")
+)
+
+const (
+	version = 0x01
+
+	// SchemeX25519AES256GCM seals keys with AES-256-GCM using a key derived
+	// with HKDF-SHA256 from the X25519 ECDH shared secret
+	// between an ephemeral key and the recipient key
+	SchemeX25519AES256GCM = 0x01
+
+	maxKeys = 0xFF
+)
+
+const hkdfInfo = "cinode key bundle v1"
+
+// Layout of the bundle:
+//
+//	version           - 1 byte
+//	scheme            - 1 byte
+//	name length       - 1 byte
+//	name              - name length bytes
+//	ephemeral pub key - 32 bytes
+//	sealed keys       - the rest
+//
+// Everything before sealed keys is used as the AEAD associated data.
+// Sealed plaintext is a sequence of keys, each one prefixed with its length byte.
+type header struct {
+	name         *blob.Name
+	ephemeralPub *ecdh.PublicKey
+	raw          []byte
+}
+
+// Seal encrypts blob keys so that those can only be opened by the owner of
+// the recipient's private key. The bundle also carries the blob name that
+// the keys belong to, the name is authenticated but not encrypted.
+//
+// Random data is read from rnd, crypto/rand.Reader is used if rnd is nil.
+func Seal(recipient *ecdh.PublicKey, name *blob.Name, keys []*blob.Key, rnd io.Reader) ([]byte, error) {
+	if recipient == nil || recipient.Curve() != ecdh.X25519() {
+		return nil, ErrInvalidRecipient
+	}
+	if name == nil {
+		return nil, fmt.Errorf("%w: missing blob name", ErrInvalidBundle)
+	}
+	if len(keys) == 0 || len(keys) > maxKeys {
+		return nil, fmt.Errorf("%w: invalid number of keys: %d", ErrInvalidBundle, len(keys))
+	}
+
+	plaintext := []byte{}
+	for _, k := range keys {
+		if k == nil {
+			return nil, fmt.Errorf("%w: missing key", ErrInvalidBundle)
+		}
+		kb := k.Bytes()
+		if len(kb) == 0 || len(kb) > 0xFF {
+			return nil, fmt.Errorf("%w: invalid key length: %d", ErrInvalidBundle, len(kb))
+		}
+		plaintext = append(plaintext, byte(len(kb)))
+		plaintext = append(plaintext, kb...)
+	}
+
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	ephemeralBytes := make([]byte, 32)
+	if _, err := io.ReadFull(rnd, ephemeralBytes); err != nil {
+		return nil, fmt.Errorf("failed to read random data: %w", err)
+	}
+	ephemeral, err := ecdh.X25519().NewPrivateKey(ephemeralBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	nameBytes := name.Bytes()
+	hdr := []byte{version, SchemeX25519AES256GCM, byte(len(nameBytes))}
+	hdr = append(hdr, nameBytes...)
+	hdr = append(hdr, ephemeral.PublicKey().Bytes()...)
+
+	aead, err := newAEAD(ephemeral, recipient, ephemeral.PublicKey().Bytes(), recipient.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	// The encryption key is used only once thus the nonce can be constant
+	return aead.Seal(hdr, make([]byte, aead.NonceSize()), plaintext, hdr), nil
+}
+
+// Name returns the blob name the bundle was created for, the name is not
+// authenticated until the bundle is opened
+func Name(bundle []byte) (*blob.Name, error) {
+	hdr, err := parseHeader(bundle)
+	if err != nil {
+		return nil, err
+	}
+	return hdr.name, nil
+}
+
+// Open decrypts keys from the bundle using the recipient's private key
+func Open(recipient *ecdh.PrivateKey, bundle []byte) (*blob.Name, []*blob.Key, error) {
+	if recipient == nil || recipient.Curve() != ecdh.X25519() {
+		return nil, nil, ErrInvalidRecipient
+	}
+
+	hdr, err := parseHeader(bundle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	aead, err := newAEAD(recipient, hdr.ephemeralPub, hdr.ephemeralPub.Bytes(), recipient.PublicKey().Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plaintext, err := aead.Open(nil, make([]byte, aead.NonceSize()), bundle[len(hdr.raw):], hdr.raw)
+	if err != nil {
+		return nil, nil, ErrDecryptionFailed
+	}
+	defer clear(plaintext)
+
+	keys := []*blob.Key{}
+	for len(plaintext) > 0 {
+		l := int(plaintext[0])
+		if l == 0 || len(plaintext) < l+1 {
+			return nil, nil, fmt.Errorf("%w: malformed keys", ErrInvalidBundle)
+		}
+		keys = append(keys, blob.KeyFromBytes(plaintext[1:l+1]))
+		plaintext = plaintext[l+1:]
+	}
+	if len(keys) == 0 {
+		return nil, nil, fmt.Errorf("%w: no keys", ErrInvalidBundle)
+	}
+
+	return hdr.name, keys, nil
+}
+
+func parseHeader(bundle []byte) (*header, error) {
+	if len(bundle) < 3 {
+		return nil, fmt.Errorf("%w: truncated header", ErrInvalidBundle)
+	}
+	if bundle[0] != version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, bundle[0])
+	}
+	if bundle[1] != SchemeX25519AES256GCM {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedScheme, bundle[1])
+	}
+
+	nameLen := int(bundle[2])
+	hdrLen := 3 + nameLen + 32
+	if len(bundle) < hdrLen {
+		return nil, fmt.Errorf("%w: truncated header", ErrInvalidBundle)
+	}
+
+	name, err := blob.NameFromBytes(bundle[3 : 3+nameLen])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+	}
+
+	ephemeralPub, err := ecdh.X25519().NewPublicKey(bundle[3+nameLen : hdrLen])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+	}
+
+	return &header{
+		name:         name,
+		ephemeralPub: ephemeralPub,
+		raw:          bundle[:hdrLen],
+	}, nil
+}
+
+func newAEAD(priv *ecdh.PrivateKey, pub *ecdh.PublicKey, ephemeralPub, recipientPub []byte) (cipher.AEAD, error) {
+	shared, err := priv.ECDH(pub)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRecipient, err)
+	}
+	defer clear(shared)
+
+	// Both public keys are mixed in to bind the derived key to the recipient
+	salt := append(append([]byte{}, ephemeralPub...), recipientPub...)
+
+	key, err := hkdf.Key(sha256.New, shared, salt, hkdfInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,171 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keybundle_test
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/cinode/go-common/blob"
+	"github.com/cinode/go-common/blobtypes"
+	"github.com/cinode/go-common/cutl"
+	"github.com/cinode/go-common/keybundle"
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+func testData(t *testing.T) (*ecdh.PrivateKey, *blob.Name, []*blob.Key) {
+	recipient, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	hash := sha256.Sum256([]byte("blob content"))
+	name := cutl.Must(blob.NameFromHashAndType(hash[:], blobtypes.Static))
+
+	keys := []*blob.Key{
+		cutl.Must(blob.GenerateKey(blob.CipherXChaCha20, nil)),
+		cutl.Must(blob.GenerateKey(blob.CipherAES256GCM, nil)),
+		blob.KeyFromBytes([]byte{1}),
+	}
+
+	return recipient, name, keys
+}
+
+func TestSealOpen(t *testing.T) {
+	recipient, name, keys := testData(t)
+
+	bundle, err := keybundle.Seal(recipient.PublicKey(), name, keys, nil)
+	require.NoError(t, err)
+
+	bundleName, err := keybundle.Name(bundle)
+	require.NoError(t, err)
+	require.True(t, name.Equal(bundleName))
+
+	openedName, openedKeys, err := keybundle.Open(recipient, bundle)
+	require.NoError(t, err)
+	require.True(t, name.Equal(openedName))
+	require.Len(t, openedKeys, len(keys))
+	for i := range keys {
+		require.True(t, keys[i].Equal(openedKeys[i]))
+	}
+
+	// Ephemeral key is random, sealing the same data twice gives different bundles
+	bundle2, err := keybundle.Seal(recipient.PublicKey(), name, keys, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, bundle, bundle2)
+}
+
+func TestSealDeterministic(t *testing.T) {
+	recipient, name, keys := testData(t)
+
+	entropy := bytes.Repeat([]byte{0x5A}, 32)
+	bundle1, err := keybundle.Seal(recipient.PublicKey(), name, keys, bytes.NewReader(entropy))
+	require.NoError(t, err)
+	bundle2, err := keybundle.Seal(recipient.PublicKey(), name, keys, bytes.NewReader(entropy))
+	require.NoError(t, err)
+	require.Equal(t, bundle1, bundle2)
+
+	_, err = keybundle.Seal(recipient.PublicKey(), name, keys, bytes.NewReader(entropy[:10]))
+	require.NotNil(t, err)
+}
+
+func TestOpenWrongRecipient(t *testing.T) {
+	recipient, name, keys := testData(t)
+	other, _, _ := testData(t)
+
+	bundle, err := keybundle.Seal(recipient.PublicKey(), name, keys, nil)
+	require.NoError(t, err)
+
+	_, _, err = keybundle.Open(other, bundle)
+	require.ErrorIs(t, err, keybundle.ErrDecryptionFailed)
+}
+
+func TestOpenTampered(t *testing.T) {
+	recipient, name, keys := testData(t)
+
+	bundle, err := keybundle.Seal(recipient.PublicKey(), name, keys, nil)
+	require.NoError(t, err)
+
+	for i := range bundle {
+		t.Run(fmt.Sprintf("byte %d", i), func(t *testing.T) {
+			tampered := bytes.Clone(bundle)
+			tampered[i] ^= 0x01
+
+			_, _, err := keybundle.Open(recipient, tampered)
+			require.NotNil(t, err)
+		})
+	}
+
+	for i := range bundle {
+		_, _, err := keybundle.Open(recipient, bundle[:i])
+		require.NotNil(t, err)
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	recipient, name, keys := testData(t)
+
+	p256Key, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	_, err = keybundle.Seal(nil, name, keys, nil)
+	require.ErrorIs(t, err, keybundle.ErrInvalidRecipient)
+
+	_, err = keybundle.Seal(p256Key.PublicKey(), name, keys, nil)
+	require.ErrorIs(t, err, keybundle.ErrInvalidRecipient)
+
+	_, err = keybundle.Seal(recipient.PublicKey(), nil, keys, nil)
+	require.ErrorIs(t, err, keybundle.ErrInvalidBundle)
+
+	_, err = keybundle.Seal(recipient.PublicKey(), name, nil, nil)
+	require.ErrorIs(t, err, keybundle.ErrInvalidBundle)
+
+	_, err = keybundle.Seal(recipient.PublicKey(), name, []*blob.Key{keys[0], nil}, nil)
+	require.ErrorIs(t, err, keybundle.ErrInvalidBundle)
+
+	_, err = keybundle.Seal(recipient.PublicKey(), name, []*blob.Key{blob.KeyFromBytes(nil)}, nil)
+	require.ErrorIs(t, err, keybundle.ErrInvalidBundle)
+
+	_, err = keybundle.Seal(recipient.PublicKey(), name, []*blob.Key{blob.KeyFromBytes(make([]byte, 256))}, nil)
+	require.ErrorIs(t, err, keybundle.ErrInvalidBundle)
+
+	bundle, err := keybundle.Seal(recipient.PublicKey(), name, keys, nil)
+	require.NoError(t, err)
+
+	_, _, err = keybundle.Open(nil, bundle)
+	require.ErrorIs(t, err, keybundle.ErrInvalidRecipient)
+
+	_, _, err = keybundle.Open(p256Key, bundle)
+	require.ErrorIs(t, err, keybundle.ErrInvalidRecipient)
+
+	invalidVersion := bytes.Clone(bundle)
+	invalidVersion[0] = 0x02
+	_, err = keybundle.Name(invalidVersion)
+	require.ErrorIs(t, err, keybundle.ErrUnsupportedVersion)
+
+	invalidScheme := bytes.Clone(bundle)
+	invalidScheme[1] = 0x02
+	_, _, err = keybundle.Open(recipient, invalidScheme)
+	require.ErrorIs(t, err, keybundle.ErrUnsupportedScheme)
+
+	emptyName := bytes.Clone(bundle)
+	emptyName[2] = 0
+	_, err = keybundle.Name(emptyName)
+	require.ErrorIs(t, err, keybundle.ErrInvalidBundle)
+}
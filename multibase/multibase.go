@@ -0,0 +1,94 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multibase
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/cinode/go-common/base58"
+)
+
+var (
+	ErrUnsupportedEncoding = errors.New("unsupported multibase encoding")
+	ErrInvalidData         = errors.New("invalid multibase data")
+)
+
+// Encoding is identified by the prefix character of the multibase string,
+// see https://github.com/multiformats/multibase for the list of prefixes.
+// Only a subset of encodings from the specification is supported.
+type Encoding byte
+
+const (
+	// Base58BTC is the base58 encoding using the bitcoin alphabet
+	Base58BTC Encoding = 'z'
+
+	// Base32 is the RFC 4648 base32 encoding, lowercase without padding
+	Base32 Encoding = 'b'
+
+	// Base64URL is the RFC 4648 URL-safe base64 encoding without padding
+	Base64URL Encoding = 'u'
+)
+
+var (
+	base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+	base64URL   = base64.RawURLEncoding
+)
+
+// Encode returns the multibase representation of data using given encoding
+func Encode(e Encoding, data []byte) (string, error) {
+	switch e {
+	case Base58BTC:
+		return string(e) + base58.Encode(data), nil
+	case Base32:
+		return string(e) + base32Lower.EncodeToString(data), nil
+	case Base64URL:
+		return string(e) + base64URL.EncodeToString(data), nil
+	}
+	return "", fmt.Errorf("%w: '%c'", ErrUnsupportedEncoding, e)
+}
+
+// Decode decodes the multibase string, the encoding is detected from the prefix
+func Decode(s string) (Encoding, []byte, error) {
+	if s == "" {
+		return 0, nil, fmt.Errorf("%w: missing prefix", ErrInvalidData)
+	}
+
+	e, body := Encoding(s[0]), s[1:]
+
+	var (
+		data []byte
+		err  error
+	)
+	switch e {
+	case Base58BTC:
+		data, err = base58.Decode(body)
+	case Base32:
+		data, err = base32Lower.DecodeString(body)
+	case Base64URL:
+		data, err = base64URL.DecodeString(body)
+	default:
+		return 0, nil, fmt.Errorf("%w: '%c'", ErrUnsupportedEncoding, e)
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %w", ErrInvalidData, err)
+	}
+
+	return e, data, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multibase_test
+
+import (
+	"testing"
+
+	"github.com/cinode/go-common/multibase"
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	// Test vectors from the multibase specification
+	for _, tc := range []struct {
+		encoding multibase.Encoding
+		data     string
+		expected string
+	}{
+		{multibase.Base58BTC, "yes mani !", "z7paNL19xttacUY"},
+		{multibase.Base32, "yes mani !", "bpfsxgidnmfxgsibb"},
+		{multibase.Base64URL, "yes mani !", "ueWVzIG1hbmkgIQ"},
+		{multibase.Base58BTC, "\x00yes mani !", "z17paNL19xttacUY"},
+		{multibase.Base64URL, "", "u"},
+	} {
+		t.Run(tc.expected, func(t *testing.T) {
+			encoded, err := multibase.Encode(tc.encoding, []byte(tc.data))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, encoded)
+
+			encoding, decoded, err := multibase.Decode(encoded)
+			require.NoError(t, err)
+			require.Equal(t, tc.encoding, encoding)
+			require.Equal(t, []byte(tc.data), decoded)
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	_, err := multibase.Encode('f', []byte("data"))
+	require.ErrorIs(t, err, multibase.ErrUnsupportedEncoding)
+
+	for _, tc := range []struct {
+		input string
+		err   error
+	}{
+		{"", multibase.ErrInvalidData},
+		{"f796573206d616e692021", multibase.ErrUnsupportedEncoding},
+		{"z7paNL19xttacU@", multibase.ErrInvalidData},
+		{"bPFSXGIDNMFXGSIBB", multibase.ErrInvalidData},
+		{"ueWVzIG1hbmkgIQ==", multibase.ErrInvalidData},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			_, decoded, err := multibase.Decode(tc.input)
+			require.ErrorIs(t, err, tc.err)
+			require.Nil(t, decoded)
+		})
+	}
+}
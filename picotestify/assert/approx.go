@@ -0,0 +1,123 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assert
+
+import (
+	"math"
+	"time"
+)
+
+// inDelta checks if the difference between values is within delta, values
+// are compared as float64, two NaNs are considered equal
+func inDelta[T number](expected, actual T, delta float64) (ok bool, diff float64) {
+	e, a := float64(expected), float64(actual)
+	if math.IsNaN(e) || math.IsNaN(a) {
+		return math.IsNaN(e) && math.IsNaN(a), math.NaN()
+	}
+
+	diff = math.Abs(e - a)
+	return diff <= delta, diff
+}
+
+// InDelta asserts that the absolute difference between values is at most delta
+func InDelta[T number](t TestingT, expected, actual T, delta float64, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if ok, diff := inDelta(expected, actual, delta); !ok {
+		fail(t, msgAndArgs, "Max difference between %v and %v allowed is %v, but difference was %v",
+			expected, actual, delta, diff)
+		return false
+	}
+
+	return true
+}
+
+// InDeltaSlice asserts that slices have the same length and corresponding
+// elements are within delta
+func InDeltaSlice[S ~[]T, T number](t TestingT, expected, actual S, delta float64, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if len(expected) != len(actual) {
+		fail(t, msgAndArgs, "Slice lengths differ, expected: %d, actual: %d", len(expected), len(actual))
+		return false
+	}
+
+	for i := range expected {
+		if ok, diff := inDelta(expected[i], actual[i], delta); !ok {
+			fail(t, msgAndArgs, "Max difference between %v and %v at index %d allowed is %v, but difference was %v",
+				expected[i], actual[i], i, delta, diff)
+			return false
+		}
+	}
+
+	return true
+}
+
+// InEpsilon asserts that the relative error between values is at most epsilon,
+// the expected value must not be zero
+func InEpsilon[T number](t TestingT, expected, actual T, epsilon float64, msgAndArgs ...any) bool {
+	t.Helper()
+
+	e, a := float64(expected), float64(actual)
+	if e == 0 || math.IsNaN(e) || math.IsNaN(a) {
+		fail(t, msgAndArgs, "Relative error between %v and %v can not be calculated", expected, actual)
+		return false
+	}
+
+	relErr := math.Abs(e-a) / math.Abs(e)
+	if relErr <= epsilon {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Relative error between %v and %v is too high: %v (max allowed %v)",
+		expected, actual, relErr, epsilon)
+
+	return false
+}
+
+// WithinDuration asserts that times differ by at most delta
+func WithinDuration(t TestingT, expected, actual time.Time, delta time.Duration, msgAndArgs ...any) bool {
+	t.Helper()
+
+	diff := expected.Sub(actual)
+	if diff >= -delta && diff <= delta {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Max difference between %v and %v allowed is %v, but difference was %v",
+		expected, actual, delta, diff)
+
+	return false
+}
+
+// WithinRange asserts that the time is between start and end, inclusive
+func WithinRange(t TestingT, actual, start, end time.Time, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if end.Before(start) {
+		fail(t, msgAndArgs, "Start %v should be before end %v", start, end)
+		return false
+	}
+
+	if !actual.Before(start) && !actual.After(end) {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Time %v expected to be in range %v to %v", actual, start, end)
+
+	return false
+}
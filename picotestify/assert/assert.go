@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"runtime"
 	"strings"
 
 	"golang.org/x/exp/constraints"
@@ -47,7 +48,7 @@ func Equal[T any](t TestingT, expected, actual T, msgAndArgs ...any) bool {
 		return true
 	}
 
-	fail(t, msgAndArgs, "Values not equal, expected: %+v, actual: %+v", expected, actual)
+	fail(t, msgAndArgs, "Values not equal, expected: %+v, actual: %+v%s", expected, actual, diff(expected, actual))
 
 	return false
 }
@@ -175,6 +176,89 @@ func ErrorContains(t TestingT, err error, contains string, msgAndArgs ...any) bo
 	return false
 }
 
+func Error(t TestingT, err error, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if err != nil {
+		return true
+	}
+
+	fail(t, msgAndArgs, "An error is expected but got nil")
+
+	return false
+}
+
+func EqualError(t TestingT, err error, errString string, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if err != nil && err.Error() == errString {
+		return true
+	}
+
+	if err == nil {
+		fail(t, msgAndArgs, "Expected error %q but got nil", errString)
+	} else {
+		fail(t, msgAndArgs, "Error message not equal, expected: %q, actual: %q", errString, err.Error())
+	}
+
+	return false
+}
+
+func NotErrorIs(t TestingT, err, target error, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if !errors.Is(err, target) {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Error should not be %T: %v", target, err)
+
+	return false
+}
+
+// ErrorAs asserts that the error chain contains an error assignable to the target,
+// on success the target is set to that error.
+//
+// The target must be a pointer to an interface or to a type implementing error.
+func ErrorAs[T any](t TestingT, err error, target *T, msgAndArgs ...any) bool {
+	t.Helper()
+
+	targetType := reflect.TypeFor[T]()
+	if targetType.Kind() != reflect.Interface && !targetType.Implements(reflect.TypeFor[error]()) {
+		fail(t, msgAndArgs, "Target type %v does not implement error", targetType)
+		return false
+	}
+
+	if errors.As(err, target) {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Error chain does not contain %v: %v", targetType, err)
+
+	return false
+}
+
+// ErrorIsAll asserts that the error matches all targets, this is useful
+// to check errors built with errors.Join
+func ErrorIsAll(t TestingT, err error, targets []error, msgAndArgs ...any) bool {
+	t.Helper()
+
+	missing := []error{}
+	for _, target := range targets {
+		if !errors.Is(err, target) {
+			missing = append(missing, target)
+		}
+	}
+
+	if len(missing) == 0 {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Error does not match %v: %v", missing, err)
+
+	return false
+}
+
 func Empty(t TestingT, object any, msgAndArgs ...any) bool {
 	t.Helper()
 
@@ -206,7 +290,7 @@ func isEmpty(object any) bool {
 
 	value := reflect.ValueOf(object)
 	switch value.Kind() {
-	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
 		return value.Len() == 0
 	}
 
@@ -237,6 +321,58 @@ func GreaterOrEqual[T constraints.Ordered](t TestingT, a, b T, msgAndArgs ...any
 	return false
 }
 
+func Less[T constraints.Ordered](t TestingT, a, b T, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if a < b {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Expected %v to be less than %v", a, b)
+
+	return false
+}
+
+func LessOrEqual[T constraints.Ordered](t TestingT, a, b T, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if a <= b {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Expected %v to be less than or equal to %v", a, b)
+
+	return false
+}
+
+type number interface {
+	constraints.Integer | constraints.Float
+}
+
+func Positive[T number](t TestingT, e T, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if e > 0 {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Expected %v to be positive", e)
+
+	return false
+}
+
+func Negative[T number](t TestingT, e T, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if e < 0 {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Expected %v to be negative", e)
+
+	return false
+}
+
 func isZero(value any) bool {
 	return value == nil || reflect.DeepEqual(value, reflect.Zero(reflect.TypeOf(value)).Interface())
 }
@@ -265,10 +401,11 @@ func NotZero(t TestingT, value any, msgAndArgs ...any) bool {
 	return false
 }
 
-func didPanic(f func()) (didPanic bool) {
+// didPanic calls f and returns the recovered value if it panicked
+func didPanic(f func()) (didPanic bool, value any) {
 	didPanic = true
 
-	defer func() { _ = recover() }()
+	defer func() { value = recover() }()
 
 	f()
 	didPanic = false
@@ -276,10 +413,17 @@ func didPanic(f func()) (didPanic bool) {
 	return
 }
 
+// isNilPanic checks if the recovered value comes from panic(nil), depending on
+// the Go version and GODEBUG settings this is either nil or *runtime.PanicNilError
+func isNilPanic(value any) bool {
+	_, isPanicNilError := value.(*runtime.PanicNilError)
+	return value == nil || isPanicNilError
+}
+
 func Panics(t TestingT, f func(), msgAndArgs ...any) bool {
 	t.Helper()
 
-	if didPanic(f) {
+	if panicked, _ := didPanic(f); panicked {
 		return true
 	}
 
@@ -291,11 +435,77 @@ func Panics(t TestingT, f func(), msgAndArgs ...any) bool {
 func NotPanics(t TestingT, f func(), msgAndArgs ...any) bool {
 	t.Helper()
 
-	if !didPanic(f) {
+	panicked, value := didPanic(f)
+	if !panicked {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Expected function not to panic, panic value: %#v", value)
+
+	return false
+}
+
+// PanicValue asserts that the function panics and returns the recovered value
+// for further assertions.
+//
+// The value for panic(nil) is *runtime.PanicNilError unless disabled with GODEBUG.
+func PanicValue(t TestingT, f func(), msgAndArgs ...any) (value any, ok bool) {
+	t.Helper()
+
+	panicked, value := didPanic(f)
+	if panicked {
+		return value, true
+	}
+
+	fail(t, msgAndArgs, "Expected function to panic")
+
+	return nil, false
+}
+
+// PanicsWithValue asserts that the function panics with the expected value,
+// the nil value matches panic(nil)
+func PanicsWithValue(t TestingT, expected any, f func(), msgAndArgs ...any) bool {
+	t.Helper()
+
+	panicked, value := didPanic(f)
+	if !panicked {
+		fail(t, msgAndArgs, "Expected function to panic with value: %#v", expected)
+		return false
+	}
+
+	if expected == nil && isNilPanic(value) {
+		return true
+	}
+	if expected != nil && reflect.DeepEqual(expected, value) {
 		return true
 	}
 
-	fail(t, msgAndArgs, "Expected function not to panic")
+	fail(t, msgAndArgs, "Panic value not equal, expected: %#v, actual: %#v", expected, value)
+
+	return false
+}
+
+// PanicsWithError asserts that the function panics with an error with the expected message
+func PanicsWithError(t TestingT, errString string, f func(), msgAndArgs ...any) bool {
+	t.Helper()
+
+	panicked, value := didPanic(f)
+	if !panicked {
+		fail(t, msgAndArgs, "Expected function to panic with error: %q", errString)
+		return false
+	}
+
+	err, isError := value.(error)
+	if !isError {
+		fail(t, msgAndArgs, "Panic value is not an error: %#v", value)
+		return false
+	}
+
+	if err.Error() == errString {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Panic error message not equal, expected: %q, actual: %q", errString, err.Error())
 
 	return false
 }
@@ -322,6 +532,12 @@ func Len(t TestingT, obj any, length int, msgAndArgs ...any) bool {
 	t.Helper()
 
 	r := reflect.ValueOf(obj)
+	switch r.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+	default:
+		fail(t, msgAndArgs, "Could not get length of %#v", obj)
+		return false
+	}
 
 	if r.Len() == length {
 		return true
@@ -17,13 +17,25 @@ limitations under the License.
 package assert_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/cinode/go-common/picotestify/assert"
+	"github.com/cinode/go-common/picotestify/require"
 )
 
+type testTypedError struct{ code int }
+
+func (e *testTypedError) Error() string { return fmt.Sprintf("typed error %d", e.code) }
+func (e *testTypedError) Code() int     { return e.code }
+
 type testingMock struct {
 	helper bool
 	error  bool
@@ -155,6 +167,361 @@ func TestAssert(t *testing.T) {
 			pass: func(t assert.TestingT) { assert.Len(t, []int{1, 2, 3}, 3) },
 			fail: func(t assert.TestingT) { assert.Len(t, []int{1, 2, 3}, 2) },
 		},
+		{
+			name: "Len - map",
+			pass: func(t assert.TestingT) { assert.Len(t, map[int]int{1: 1}, 1) },
+			fail: func(t assert.TestingT) { assert.Len(t, map[int]int{1: 1}, 2) },
+		},
+		{
+			name: "Len - channel",
+			pass: func(t assert.TestingT) { assert.Len(t, make(chan int), 0) },
+			fail: func(t assert.TestingT) { assert.Len(t, 1, 0) },
+		},
+		{
+			name: "Empty - channel",
+			pass: func(t assert.TestingT) { assert.Empty(t, make(chan int, 1)) },
+			fail: func(t assert.TestingT) {
+				ch := make(chan int, 1)
+				ch <- 1
+				assert.Empty(t, ch)
+			},
+		},
+		{
+			name: "Contains",
+			pass: func(t assert.TestingT) { assert.Contains(t, []int{1, 2, 3}, 2) },
+			fail: func(t assert.TestingT) { assert.Contains(t, []int{1, 2, 3}, 4) },
+		},
+		{
+			name: "Contains - nil slice",
+			pass: func(t assert.TestingT) { assert.NotContains(t, []string(nil), "a") },
+			fail: func(t assert.TestingT) { assert.Contains(t, []string(nil), "a") },
+		},
+		{
+			name: "NotContains",
+			pass: func(t assert.TestingT) { assert.NotContains(t, []string{"a", "b"}, "c") },
+			fail: func(t assert.TestingT) { assert.NotContains(t, []string{"a", "b"}, "b") },
+		},
+		{
+			name: "ContainsString",
+			pass: func(t assert.TestingT) { assert.ContainsString(t, "test", "es") },
+			fail: func(t assert.TestingT) { assert.ContainsString(t, "test", "se") },
+		},
+		{
+			name: "NotContainsString",
+			pass: func(t assert.TestingT) { assert.NotContainsString(t, "test", "x") },
+			fail: func(t assert.TestingT) { assert.NotContainsString(t, "test", "es") },
+		},
+		{
+			name: "ContainsKey",
+			pass: func(t assert.TestingT) { assert.ContainsKey(t, map[string]int{"a": 1}, "a") },
+			fail: func(t assert.TestingT) { assert.ContainsKey(t, map[string]int{"a": 1}, "b") },
+		},
+		{
+			name: "NotContainsKey",
+			pass: func(t assert.TestingT) { assert.NotContainsKey(t, map[string]int{"a": 1}, "b") },
+			fail: func(t assert.TestingT) { assert.NotContainsKey(t, map[string]int{"a": 1}, "a") },
+		},
+		{
+			name: "ElementsMatch",
+			pass: func(t assert.TestingT) { assert.ElementsMatch(t, []int{1, 2, 2, 3}, []int{2, 3, 1, 2}) },
+			fail: func(t assert.TestingT) { assert.ElementsMatch(t, []int{1, 2, 2}, []int{1, 1, 2}) },
+		},
+		{
+			name: "Subset",
+			pass: func(t assert.TestingT) { assert.Subset(t, []int{1, 2, 3}, []int{3, 1, 1}) },
+			fail: func(t assert.TestingT) { assert.Subset(t, []int{1, 2, 3}, []int{1, 4}) },
+		},
+		{
+			name: "NotSubset",
+			pass: func(t assert.TestingT) { assert.NotSubset(t, []int{1, 2, 3}, []int{1, 4}) },
+			fail: func(t assert.TestingT) { assert.NotSubset(t, []int{1, 2, 3}, []int{3, 2}) },
+		},
+		{
+			name: "Error",
+			pass: func(t assert.TestingT) { assert.Error(t, testError) },
+			fail: func(t assert.TestingT) { assert.Error(t, nil) },
+		},
+		{
+			name: "EqualError",
+			pass: func(t assert.TestingT) { assert.EqualError(t, testError, "test-error") },
+			fail: func(t assert.TestingT) { assert.EqualError(t, testError, "test") },
+		},
+		{
+			name: "EqualError - nil",
+			pass: func(t assert.TestingT) { assert.EqualError(t, fmt.Errorf("a: %w", testError), "a: test-error") },
+			fail: func(t assert.TestingT) { assert.EqualError(t, nil, "test-error") },
+		},
+		{
+			name: "NotErrorIs",
+			pass: func(t assert.TestingT) { assert.NotErrorIs(t, testError, errors.New("error2")) },
+			fail: func(t assert.TestingT) { assert.NotErrorIs(t, fmt.Errorf("error: %w", testError), testError) },
+		},
+		{
+			name: "ErrorAs",
+			pass: func(t assert.TestingT) {
+				var target *testTypedError
+				assert.ErrorAs(t, fmt.Errorf("error: %w", &testTypedError{}), &target)
+			},
+			fail: func(t assert.TestingT) {
+				var target *testTypedError
+				assert.ErrorAs(t, testError, &target)
+			},
+		},
+		{
+			name: "ErrorAs - invalid target",
+			pass: func(t assert.TestingT) {
+				var target interface{ Code() int }
+				assert.ErrorAs(t, &testTypedError{}, &target)
+			},
+			fail: func(t assert.TestingT) {
+				var target testTypedError
+				assert.ErrorAs(t, &testTypedError{}, &target)
+			},
+		},
+		{
+			name: "ErrorIsAll",
+			pass: func(t assert.TestingT) {
+				err2 := errors.New("error2")
+				assert.ErrorIsAll(t, errors.Join(testError, err2), []error{err2, testError})
+			},
+			fail: func(t assert.TestingT) {
+				assert.ErrorIsAll(t, errors.Join(testError), []error{testError, errors.New("error2")})
+			},
+		},
+		{
+			name: "Eventually",
+			pass: func(t assert.TestingT) {
+				calls := 0
+				assert.Eventually(t, func() bool { calls++; return calls == 3 }, time.Second, time.Millisecond)
+			},
+			fail: func(t assert.TestingT) {
+				assert.Eventually(t, func() bool { return false }, 20*time.Millisecond, time.Millisecond)
+			},
+		},
+		{
+			name: "Never",
+			pass: func(t assert.TestingT) {
+				assert.Never(t, func() bool { return false }, 20*time.Millisecond, time.Millisecond)
+			},
+			fail: func(t assert.TestingT) {
+				calls := 0
+				assert.Never(t, func() bool { calls++; return calls == 3 }, time.Second, time.Millisecond)
+			},
+		},
+		{
+			name: "EventuallyWithT",
+			pass: func(t assert.TestingT) {
+				calls := 0
+				assert.EventuallyWithT(t, func(c *assert.CollectT) {
+					calls++
+					require.Greater(c, calls, 2)
+					assert.Equal(c, 3, calls)
+				}, time.Second, time.Millisecond)
+			},
+			fail: func(t assert.TestingT) {
+				assert.EventuallyWithT(t, func(c *assert.CollectT) {
+					assert.True(c, false)
+				}, 20*time.Millisecond, time.Millisecond)
+			},
+		},
+		{
+			name: "Less",
+			pass: func(t assert.TestingT) { assert.Less(t, 1, 2) },
+			fail: func(t assert.TestingT) { assert.Less(t, 2, 2) },
+		},
+		{
+			name: "LessOrEqual",
+			pass: func(t assert.TestingT) { assert.LessOrEqual(t, "a", "a") },
+			fail: func(t assert.TestingT) { assert.LessOrEqual(t, "b", "a") },
+		},
+		{
+			name: "Positive",
+			pass: func(t assert.TestingT) { assert.Positive(t, 0.1) },
+			fail: func(t assert.TestingT) { assert.Positive(t, 0) },
+		},
+		{
+			name: "Negative",
+			pass: func(t assert.TestingT) { assert.Negative(t, int8(-1)) },
+			fail: func(t assert.TestingT) { assert.Negative(t, 0.0) },
+		},
+		{
+			name: "InDelta",
+			pass: func(t assert.TestingT) { assert.InDelta(t, 1.0, 1.05, 0.1) },
+			fail: func(t assert.TestingT) { assert.InDelta(t, 10, 12, 1) },
+		},
+		{
+			name: "InDelta - NaN",
+			pass: func(t assert.TestingT) { assert.InDelta(t, math.NaN(), math.NaN(), 0.1) },
+			fail: func(t assert.TestingT) { assert.InDelta(t, math.NaN(), 1, 0.1) },
+		},
+		{
+			name: "InDeltaSlice",
+			pass: func(t assert.TestingT) { assert.InDeltaSlice(t, []float64{1, 2}, []float64{1.01, 1.99}, 0.1) },
+			fail: func(t assert.TestingT) { assert.InDeltaSlice(t, []float64{1, 2}, []float64{1.01, 2.2}, 0.1) },
+		},
+		{
+			name: "InDeltaSlice - length",
+			pass: func(t assert.TestingT) { assert.InDeltaSlice(t, []int{}, nil, 0) },
+			fail: func(t assert.TestingT) { assert.InDeltaSlice(t, []int{1}, []int{1, 2}, 1) },
+		},
+		{
+			name: "InEpsilon",
+			pass: func(t assert.TestingT) { assert.InEpsilon(t, 100, 101, 0.02) },
+			fail: func(t assert.TestingT) { assert.InEpsilon(t, 100, 110, 0.02) },
+		},
+		{
+			name: "InEpsilon - zero",
+			pass: func(t assert.TestingT) { assert.InEpsilon(t, -1.0, -1.0, 0) },
+			fail: func(t assert.TestingT) { assert.InEpsilon(t, 0, 0, 1) },
+		},
+		{
+			name: "WithinDuration",
+			pass: func(t assert.TestingT) {
+				assert.WithinDuration(t, time.Unix(100, 0), time.Unix(99, 0), time.Second)
+			},
+			fail: func(t assert.TestingT) {
+				assert.WithinDuration(t, time.Unix(100, 0), time.Unix(102, 0), time.Second)
+			},
+		},
+		{
+			name: "WithinRange",
+			pass: func(t assert.TestingT) {
+				assert.WithinRange(t, time.Unix(100, 0), time.Unix(100, 0), time.Unix(101, 0))
+			},
+			fail: func(t assert.TestingT) {
+				assert.WithinRange(t, time.Unix(102, 0), time.Unix(100, 0), time.Unix(101, 0))
+			},
+		},
+		{
+			name: "WithinRange - invalid range",
+			pass: func(t assert.TestingT) {
+				assert.WithinRange(t, time.Unix(101, 0), time.Unix(100, 0), time.Unix(101, 0))
+			},
+			fail: func(t assert.TestingT) {
+				assert.WithinRange(t, time.Unix(100, 0), time.Unix(101, 0), time.Unix(99, 0))
+			},
+		},
+		{
+			name: "NotPanics - nil panic",
+			pass: func(t assert.TestingT) { assert.NotPanics(t, func() {}) },
+			fail: func(t assert.TestingT) { assert.NotPanics(t, func() { panic(nil) }) },
+		},
+		{
+			name: "PanicValue",
+			pass: func(t assert.TestingT) { assert.PanicValue(t, func() { panic(testError) }) },
+			fail: func(t assert.TestingT) { assert.PanicValue(t, func() {}) },
+		},
+		{
+			name: "PanicsWithValue",
+			pass: func(t assert.TestingT) { assert.PanicsWithValue(t, []int{1, 2}, func() { panic([]int{1, 2}) }) },
+			fail: func(t assert.TestingT) { assert.PanicsWithValue(t, []int{1, 2}, func() { panic([]int{1}) }) },
+		},
+		{
+			name: "PanicsWithValue - no panic",
+			pass: func(t assert.TestingT) { assert.PanicsWithValue(t, "test", func() { panic("test") }) },
+			fail: func(t assert.TestingT) { assert.PanicsWithValue(t, "test", func() {}) },
+		},
+		{
+			name: "PanicsWithValue - nil",
+			pass: func(t assert.TestingT) { assert.PanicsWithValue(t, nil, func() { panic(nil) }) },
+			fail: func(t assert.TestingT) { assert.PanicsWithValue(t, nil, func() { panic(0) }) },
+		},
+		{
+			name: "PanicsWithError",
+			pass: func(t assert.TestingT) { assert.PanicsWithError(t, "test-error", func() { panic(testError) }) },
+			fail: func(t assert.TestingT) { assert.PanicsWithError(t, "test", func() { panic(testError) }) },
+		},
+		{
+			name: "PanicsWithError - not an error",
+			pass: func(t assert.TestingT) {
+				assert.PanicsWithError(t, "typed error 1", func() { panic(&testTypedError{code: 1}) })
+			},
+			fail: func(t assert.TestingT) { assert.PanicsWithError(t, "test-error", func() { panic("test-error") }) },
+		},
+		{
+			name: "PanicsWithError - no panic",
+			pass: func(t assert.TestingT) { assert.PanicsWithError(t, "test-error", func() { panic(testError) }) },
+			fail: func(t assert.TestingT) { assert.PanicsWithError(t, "test-error", func() {}) },
+		},
+		{
+			name: "Exactly",
+			pass: func(t assert.TestingT) { assert.Exactly(t, int32(1), int32(1)) },
+			fail: func(t assert.TestingT) { assert.Exactly(t, int32(1), int64(1)) },
+		},
+		{
+			name: "Exactly - values",
+			pass: func(t assert.TestingT) { assert.Exactly(t, []int{1}, []int{1}) },
+			fail: func(t assert.TestingT) { assert.Exactly(t, []int{1}, []int{2}) },
+		},
+		{
+			name: "IsType",
+			pass: func(t assert.TestingT) { assert.IsType(t, &testTypedError{}, &testTypedError{code: 1}) },
+			fail: func(t assert.TestingT) { assert.IsType(t, &testTypedError{}, testTypedError{}) },
+		},
+		{
+			name: "Implements",
+			pass: func(t assert.TestingT) { assert.Implements[error](t, &testTypedError{}) },
+			fail: func(t assert.TestingT) { assert.Implements[error](t, testTypedError{}) },
+		},
+		{
+			name: "Implements - not an interface",
+			pass: func(t assert.TestingT) { assert.Implements[fmt.Stringer](t, time.Second) },
+			fail: func(t assert.TestingT) { assert.Implements[testTypedError](t, testTypedError{}) },
+		},
+		{
+			name: "Same",
+			pass: func(t assert.TestingT) {
+				v := 1
+				assert.Same(t, &v, &v)
+			},
+			fail: func(t assert.TestingT) {
+				v1, v2 := 1, 1
+				assert.Same(t, &v1, &v2)
+			},
+		},
+		{
+			name: "NotSame",
+			pass: func(t assert.TestingT) {
+				b := []byte{1, 2}
+				assert.NotSame(t, &b[0], &bytes.Clone(b)[0])
+			},
+			fail: func(t assert.TestingT) {
+				b := []byte{1, 2}
+				assert.NotSame(t, &b[0], &b[:1][0])
+			},
+		},
+		{
+			name: "EqualValues",
+			pass: func(t assert.TestingT) { assert.EqualValues(t, int32(1), int64(1)) },
+			fail: func(t assert.TestingT) { assert.EqualValues(t, 1.5, 1) },
+		},
+		{
+			name: "EqualValues - named types",
+			pass: func(t assert.TestingT) { assert.EqualValues(t, time.Duration(5), 5) },
+			fail: func(t assert.TestingT) { assert.EqualValues(t, "A", 65) },
+		},
+		{
+			name: "EqualValues - slices",
+			pass: func(t assert.TestingT) { assert.EqualValues(t, []byte("ab"), json.RawMessage("ab")) },
+			fail: func(t assert.TestingT) { assert.EqualValues(t, []byte("ab"), nil) },
+		},
+		{
+			name: "JSONEq",
+			pass: func(t assert.TestingT) { assert.JSONEq(t, `{"a": 1, "b": [true, null]}`, `{"b":[true,null],"a":1.0}`) },
+			fail: func(t assert.TestingT) {
+				assert.JSONEq(t, `{"a": 1, "b": [true, null]}`, `{"a": 1, "b": [null, true]}`)
+			},
+		},
+		{
+			name: "JSONEq - invalid",
+			pass: func(t assert.TestingT) { assert.JSONEq(t, `"a"`, ` "a" `) },
+			fail: func(t assert.TestingT) { assert.JSONEq(t, `{"a": 1}`, `{"a": 1`) },
+		},
+		{
+			name: "JSONEq - invalid expected",
+			pass: func(t assert.TestingT) { assert.JSONEq(t, `[]`, `[]`) },
+			fail: func(t assert.TestingT) { assert.JSONEq(t, `{`, `{}`) },
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Run("pass", func(t *testing.T) {
@@ -172,3 +539,47 @@ func TestAssert(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorAsSetsTarget(t *testing.T) {
+	var target *testTypedError
+	assert.True(t, assert.ErrorAs(t, fmt.Errorf("error: %w", &testTypedError{code: 7}), &target))
+	assert.Equal(t, 7, target.code)
+}
+
+type testingMockWithContext struct {
+	testingMock
+	ctx context.Context
+}
+
+func (t *testingMockWithContext) Context() context.Context { return t.ctx }
+
+func TestEventuallyInterruptedByTestContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	for _, f := range []func(t assert.TestingT) bool{
+		func(t assert.TestingT) bool {
+			return assert.Eventually(t, func() bool { return false }, time.Hour, time.Millisecond)
+		},
+		func(t assert.TestingT) bool {
+			return assert.Never(t, func() bool { return false }, time.Hour, time.Millisecond)
+		},
+	} {
+		hlp := &testingMockWithContext{ctx: ctx}
+		start := time.Now()
+		assert.False(t, f(hlp))
+		assert.True(t, hlp.error)
+		assert.Greater(t, time.Minute, time.Since(start))
+	}
+}
+
+func TestPanicValue(t *testing.T) {
+	value, ok := assert.PanicValue(t, func() { panic(testTypedError{code: 3}) })
+	assert.True(t, ok)
+	assert.Equal(t, any(testTypedError{code: 3}), value)
+
+	value, ok = assert.PanicValue(t, func() { panic(nil) })
+	assert.True(t, ok)
+	var nilErr *runtime.PanicNilError
+	assert.ErrorAs(t, value.(error), &nilErr)
+}
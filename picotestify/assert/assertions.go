@@ -0,0 +1,527 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assert
+
+import (
+	"errors"
+	"reflect"
+	"time"
+)
+
+// Assertions provides assertion methods bound to a single TestingT
+//
+// Go methods can not have type parameters thus methods corresponding to generic
+// assertion functions accept arguments of type any. Those arguments are checked
+// at runtime instead - ordered values must be of the same type, numeric values
+// are converted to float64, lists must be slices or arrays and maps are converted
+// to map[any]any. Call sites are the same as for the generic functions so switching
+// to testify is still possible.
+type Assertions struct {
+	t TestingT
+}
+
+func New(t TestingT) *Assertions { return &Assertions{t: t} }
+
+func (a *Assertions) True(condition bool, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return True(a.t, condition, msgAndArgs...)
+}
+
+func (a *Assertions) False(condition bool, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return False(a.t, condition, msgAndArgs...)
+}
+
+func (a *Assertions) Nil(object any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return Nil(a.t, object, msgAndArgs...)
+}
+
+func (a *Assertions) NotNil(object any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return NotNil(a.t, object, msgAndArgs...)
+}
+
+func (a *Assertions) NoError(err error, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return NoError(a.t, err, msgAndArgs...)
+}
+
+func (a *Assertions) ErrorIs(err, target error, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return ErrorIs(a.t, err, target, msgAndArgs...)
+}
+
+func (a *Assertions) ErrorContains(err error, contains string, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return ErrorContains(a.t, err, contains, msgAndArgs...)
+}
+
+func (a *Assertions) Error(err error, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return Error(a.t, err, msgAndArgs...)
+}
+
+func (a *Assertions) EqualError(err error, errString string, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return EqualError(a.t, err, errString, msgAndArgs...)
+}
+
+func (a *Assertions) NotErrorIs(err, target error, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return NotErrorIs(a.t, err, target, msgAndArgs...)
+}
+
+func (a *Assertions) ErrorIsAll(err error, targets []error, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return ErrorIsAll(a.t, err, targets, msgAndArgs...)
+}
+
+func (a *Assertions) Empty(object any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return Empty(a.t, object, msgAndArgs...)
+}
+
+func (a *Assertions) NotEmpty(object any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return NotEmpty(a.t, object, msgAndArgs...)
+}
+
+func (a *Assertions) Zero(value any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return Zero(a.t, value, msgAndArgs...)
+}
+
+func (a *Assertions) NotZero(value any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return NotZero(a.t, value, msgAndArgs...)
+}
+
+func (a *Assertions) Panics(f func(), msgAndArgs ...any) bool {
+	a.t.Helper()
+	return Panics(a.t, f, msgAndArgs...)
+}
+
+func (a *Assertions) NotPanics(f func(), msgAndArgs ...any) bool {
+	a.t.Helper()
+	return NotPanics(a.t, f, msgAndArgs...)
+}
+
+func (a *Assertions) PanicsWithValue(expected any, f func(), msgAndArgs ...any) bool {
+	a.t.Helper()
+	return PanicsWithValue(a.t, expected, f, msgAndArgs...)
+}
+
+func (a *Assertions) PanicsWithError(errString string, f func(), msgAndArgs ...any) bool {
+	a.t.Helper()
+	return PanicsWithError(a.t, errString, f, msgAndArgs...)
+}
+
+func (a *Assertions) Regexp(pattern, text string, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return Regexp(a.t, pattern, text, msgAndArgs...)
+}
+
+func (a *Assertions) Len(obj any, length int, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return Len(a.t, obj, length, msgAndArgs...)
+}
+
+func (a *Assertions) Contains(s any, contains any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	l, ok := a.comparableList(msgAndArgs, s)
+	return ok && Contains(a.t, l, contains, msgAndArgs...)
+}
+
+func (a *Assertions) NotContains(s any, contains any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	l, ok := a.comparableList(msgAndArgs, s)
+	return ok && NotContains(a.t, l, contains, msgAndArgs...)
+}
+
+func (a *Assertions) ContainsString(s, substr string, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return ContainsString(a.t, s, substr, msgAndArgs...)
+}
+
+func (a *Assertions) NotContainsString(s, substr string, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return NotContainsString(a.t, s, substr, msgAndArgs...)
+}
+
+func (a *Assertions) ContainsKey(m any, key any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	mm, ok := a.anyMap(msgAndArgs, m)
+	return ok && ContainsKey(a.t, mm, key, msgAndArgs...)
+}
+
+func (a *Assertions) NotContainsKey(m any, key any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	mm, ok := a.anyMap(msgAndArgs, m)
+	return ok && NotContainsKey(a.t, mm, key, msgAndArgs...)
+}
+
+func (a *Assertions) WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return WithinDuration(a.t, expected, actual, delta, msgAndArgs...)
+}
+
+func (a *Assertions) WithinRange(actual, start, end time.Time, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return WithinRange(a.t, actual, start, end, msgAndArgs...)
+}
+
+func (a *Assertions) Eventually(condition func() bool, waitFor, tick time.Duration, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return Eventually(a.t, condition, waitFor, tick, msgAndArgs...)
+}
+
+func (a *Assertions) Never(condition func() bool, waitFor, tick time.Duration, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return Never(a.t, condition, waitFor, tick, msgAndArgs...)
+}
+
+func (a *Assertions) EventuallyWithT(
+	condition func(collect *CollectT), waitFor, tick time.Duration, msgAndArgs ...any,
+) bool {
+	a.t.Helper()
+	return EventuallyWithT(a.t, condition, waitFor, tick, msgAndArgs...)
+}
+
+func (a *Assertions) PanicValue(f func(), msgAndArgs ...any) (value any, ok bool) {
+	a.t.Helper()
+	return PanicValue(a.t, f, msgAndArgs...)
+}
+
+func (a *Assertions) Equal(expected, actual any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return Equal(a.t, expected, actual, msgAndArgs...)
+}
+
+func (a *Assertions) NotEqual(expected, actual any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return NotEqual(a.t, expected, actual, msgAndArgs...)
+}
+
+// ErrorAs is the same as the ErrorAs function, the target must be a non-nil pointer
+// to an interface or to a type implementing error
+func (a *Assertions) ErrorAs(err error, target any, msgAndArgs ...any) bool {
+	a.t.Helper()
+
+	tv := reflect.ValueOf(target)
+	if tv.Kind() != reflect.Pointer || tv.IsNil() {
+		fail(a.t, msgAndArgs, "Target must be a non-nil pointer, got: %T", target)
+		return false
+	}
+
+	targetType := tv.Type().Elem()
+	if targetType.Kind() != reflect.Interface && !targetType.Implements(reflect.TypeFor[error]()) {
+		fail(a.t, msgAndArgs, "Target type %v does not implement error", targetType)
+		return false
+	}
+
+	if errors.As(err, target) {
+		return true
+	}
+
+	fail(a.t, msgAndArgs, "Error chain does not contain %v: %v", targetType, err)
+
+	return false
+}
+
+// orderedFuncs contains instantiations of a generic assertion for all kinds of ordered values
+type orderedFuncs struct {
+	ints    func(t TestingT, a, b int64, msgAndArgs ...any) bool
+	uints   func(t TestingT, a, b uint64, msgAndArgs ...any) bool
+	floats  func(t TestingT, a, b float64, msgAndArgs ...any) bool
+	strings func(t TestingT, a, b string, msgAndArgs ...any) bool
+}
+
+// ordered calls the assertion matching the kind of values, both values must be of the same type
+func (a *Assertions) ordered(funcs orderedFuncs, e1, e2 any, msgAndArgs []any) bool {
+	a.t.Helper()
+
+	v1, v2 := reflect.ValueOf(e1), reflect.ValueOf(e2)
+	if !v1.IsValid() || !v2.IsValid() || v1.Type() != v2.Type() {
+		fail(a.t, msgAndArgs, "Values must be of the same ordered type, got: %T and %T", e1, e2)
+		return false
+	}
+
+	switch {
+	case v1.CanInt():
+		return funcs.ints(a.t, v1.Int(), v2.Int(), msgAndArgs...)
+	case v1.CanUint():
+		return funcs.uints(a.t, v1.Uint(), v2.Uint(), msgAndArgs...)
+	case v1.CanFloat():
+		return funcs.floats(a.t, v1.Float(), v2.Float(), msgAndArgs...)
+	case v1.Kind() == reflect.String:
+		return funcs.strings(a.t, v1.String(), v2.String(), msgAndArgs...)
+	}
+
+	fail(a.t, msgAndArgs, "Values must be of the same ordered type, got: %T and %T", e1, e2)
+
+	return false
+}
+
+func (a *Assertions) Greater(e1, e2 any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return a.ordered(orderedFuncs{Greater[int64], Greater[uint64], Greater[float64], Greater[string]}, e1, e2, msgAndArgs)
+}
+
+func (a *Assertions) GreaterOrEqual(e1, e2 any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return a.ordered(
+		orderedFuncs{GreaterOrEqual[int64], GreaterOrEqual[uint64], GreaterOrEqual[float64], GreaterOrEqual[string]},
+		e1, e2, msgAndArgs,
+	)
+}
+
+func (a *Assertions) Less(e1, e2 any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return a.ordered(orderedFuncs{Less[int64], Less[uint64], Less[float64], Less[string]}, e1, e2, msgAndArgs)
+}
+
+func (a *Assertions) LessOrEqual(e1, e2 any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return a.ordered(
+		orderedFuncs{LessOrEqual[int64], LessOrEqual[uint64], LessOrEqual[float64], LessOrEqual[string]},
+		e1, e2, msgAndArgs,
+	)
+}
+
+// toFloat converts a numeric value to float64
+func toFloat(v any) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch {
+	case !rv.IsValid():
+		return 0, false
+	case rv.CanInt():
+		return float64(rv.Int()), true
+	case rv.CanUint():
+		return float64(rv.Uint()), true
+	case rv.CanFloat():
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
+// floats converts numeric values to float64, reports failure if any of those is not numeric
+func (a *Assertions) floats(msgAndArgs []any, values ...any) ([]float64, bool) {
+	a.t.Helper()
+
+	ret := make([]float64, len(values))
+	for i, v := range values {
+		f, ok := toFloat(v)
+		if !ok {
+			fail(a.t, msgAndArgs, "Value must be numeric, got: %#v", v)
+			return nil, false
+		}
+		ret[i] = f
+	}
+
+	return ret, true
+}
+
+func (a *Assertions) Positive(e any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	f, ok := a.floats(msgAndArgs, e)
+	return ok && Positive(a.t, f[0], msgAndArgs...)
+}
+
+func (a *Assertions) Negative(e any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	f, ok := a.floats(msgAndArgs, e)
+	return ok && Negative(a.t, f[0], msgAndArgs...)
+}
+
+func (a *Assertions) InDelta(expected, actual any, delta float64, msgAndArgs ...any) bool {
+	a.t.Helper()
+	f, ok := a.floats(msgAndArgs, expected, actual)
+	return ok && InDelta(a.t, f[0], f[1], delta, msgAndArgs...)
+}
+
+func (a *Assertions) InEpsilon(expected, actual any, epsilon float64, msgAndArgs ...any) bool {
+	a.t.Helper()
+	f, ok := a.floats(msgAndArgs, expected, actual)
+	return ok && InEpsilon(a.t, f[0], f[1], epsilon, msgAndArgs...)
+}
+
+// list converts a slice or an array to a slice of its elements
+func (a *Assertions) list(msgAndArgs []any, list any) ([]any, bool) {
+	a.t.Helper()
+
+	v := reflect.ValueOf(list)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		fail(a.t, msgAndArgs, "Value must be a slice or an array, got: %#v", list)
+		return nil, false
+	}
+
+	ret := make([]any, v.Len())
+	for i := range ret {
+		ret[i] = v.Index(i).Interface()
+	}
+
+	return ret, true
+}
+
+func (a *Assertions) InDeltaSlice(expected, actual any, delta float64, msgAndArgs ...any) bool {
+	a.t.Helper()
+
+	e, ok := a.list(msgAndArgs, expected)
+	if !ok {
+		return false
+	}
+	ac, ok := a.list(msgAndArgs, actual)
+	if !ok {
+		return false
+	}
+	ef, ok := a.floats(msgAndArgs, e...)
+	if !ok {
+		return false
+	}
+	af, ok := a.floats(msgAndArgs, ac...)
+	if !ok {
+		return false
+	}
+
+	return InDeltaSlice(a.t, ef, af, delta, msgAndArgs...)
+}
+
+// comparableList converts a slice or an array of comparable elements to a slice of its elements
+func (a *Assertions) comparableList(msgAndArgs []any, list any) ([]any, bool) {
+	a.t.Helper()
+
+	l, ok := a.list(msgAndArgs, list)
+	if !ok {
+		return nil, false
+	}
+	if !reflect.TypeOf(list).Elem().Comparable() {
+		fail(a.t, msgAndArgs, "Elements must be comparable, got: %T", list)
+		return nil, false
+	}
+
+	return l, true
+}
+
+// anyMap converts a map to a map with keys and values of type any
+func (a *Assertions) anyMap(msgAndArgs []any, m any) (map[any]any, bool) {
+	a.t.Helper()
+
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Map {
+		fail(a.t, msgAndArgs, "Value must be a map, got: %#v", m)
+		return nil, false
+	}
+
+	ret := make(map[any]any, v.Len())
+	for iter := v.MapRange(); iter.Next(); {
+		ret[iter.Key().Interface()] = iter.Value().Interface()
+	}
+
+	return ret, true
+}
+
+// lists converts both arguments to slices of elements
+func (a *Assertions) lists(msgAndArgs []any, listA, listB any) ([]any, []any, bool) {
+	a.t.Helper()
+
+	la, ok := a.list(msgAndArgs, listA)
+	if !ok {
+		return nil, nil, false
+	}
+	lb, ok := a.list(msgAndArgs, listB)
+	if !ok {
+		return nil, nil, false
+	}
+
+	return la, lb, true
+}
+
+func (a *Assertions) ElementsMatch(listA, listB any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	la, lb, ok := a.lists(msgAndArgs, listA, listB)
+	return ok && ElementsMatch(a.t, la, lb, msgAndArgs...)
+}
+
+func (a *Assertions) Subset(list, subset any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	l, s, ok := a.lists(msgAndArgs, list, subset)
+	return ok && Subset(a.t, l, s, msgAndArgs...)
+}
+
+func (a *Assertions) NotSubset(list, subset any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	l, s, ok := a.lists(msgAndArgs, list, subset)
+	return ok && NotSubset(a.t, l, s, msgAndArgs...)
+}
+
+func (a *Assertions) Exactly(expected, actual any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return Exactly(a.t, expected, actual, msgAndArgs...)
+}
+
+func (a *Assertions) IsType(expectedType, object any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return IsType(a.t, expectedType, object, msgAndArgs...)
+}
+
+// Implements uses the same convention as testify, the interface type is
+// passed as a nil pointer to the interface, e.g. (*io.Reader)(nil)
+func (a *Assertions) Implements(interfaceObject, object any, msgAndArgs ...any) bool {
+	a.t.Helper()
+
+	it := reflect.TypeOf(interfaceObject)
+	if it == nil || it.Kind() != reflect.Pointer {
+		fail(a.t, msgAndArgs, "Interface must be given as a pointer to the interface type, got: %T", interfaceObject)
+		return false
+	}
+
+	return implements(a.t, it.Elem(), object, msgAndArgs)
+}
+
+// pointers checks that both values are pointers of the same type
+func (a *Assertions) pointers(msgAndArgs []any, expected, actual any) bool {
+	a.t.Helper()
+
+	te, ta := reflect.TypeOf(expected), reflect.TypeOf(actual)
+	if te == nil || te.Kind() != reflect.Pointer || te != ta {
+		fail(a.t, msgAndArgs, "Values must be pointers of the same type, got: %T and %T", expected, actual)
+		return false
+	}
+
+	return true
+}
+
+func (a *Assertions) Same(expected, actual any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return a.pointers(msgAndArgs, expected, actual) && same(a.t, expected == actual, expected, actual, msgAndArgs)
+}
+
+func (a *Assertions) NotSame(expected, actual any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return a.pointers(msgAndArgs, expected, actual) && notSame(a.t, expected == actual, actual, msgAndArgs)
+}
+
+func (a *Assertions) EqualValues(expected, actual any, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return EqualValues(a.t, expected, actual, msgAndArgs...)
+}
+
+func (a *Assertions) JSONEq(expected, actual string, msgAndArgs ...any) bool {
+	a.t.Helper()
+	return JSONEq(a.t, expected, actual, msgAndArgs...)
+}
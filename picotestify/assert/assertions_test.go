@@ -0,0 +1,251 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assert_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cinode/go-common/picotestify/assert"
+)
+
+func TestAssertions(t *testing.T) {
+	var testError = errors.New("test-error")
+
+	for _, tt := range []struct {
+		pass func(a *assert.Assertions)
+		fail func(a *assert.Assertions)
+		name string
+	}{
+		{
+			name: "Equal",
+			pass: func(a *assert.Assertions) { a.Equal([]int{1}, []int{1}) },
+			fail: func(a *assert.Assertions) { a.Equal(1, int64(1)) },
+		},
+		{
+			name: "NotEqual",
+			pass: func(a *assert.Assertions) { a.NotEqual(1, int64(1)) },
+			fail: func(a *assert.Assertions) { a.NotEqual("a", "a") },
+		},
+		{
+			name: "NoError",
+			pass: func(a *assert.Assertions) { a.NoError(nil) },
+			fail: func(a *assert.Assertions) { a.NoError(testError) },
+		},
+		{
+			name: "ErrorAs",
+			pass: func(a *assert.Assertions) {
+				var target *testTypedError
+				a.ErrorAs(fmt.Errorf("error: %w", &testTypedError{}), &target)
+			},
+			fail: func(a *assert.Assertions) {
+				var target *testTypedError
+				a.ErrorAs(testError, &target)
+			},
+		},
+		{
+			name: "ErrorAs - invalid target",
+			pass: func(a *assert.Assertions) {
+				var target error
+				a.ErrorAs(testError, &target)
+			},
+			fail: func(a *assert.Assertions) { a.ErrorAs(testError, nil) },
+		},
+		{
+			name: "ErrorAs - target not an error",
+			pass: func(a *assert.Assertions) {
+				var target interface{ Code() int }
+				a.ErrorAs(&testTypedError{}, &target)
+			},
+			fail: func(a *assert.Assertions) {
+				var target int
+				a.ErrorAs(testError, &target)
+			},
+		},
+		{
+			name: "Greater",
+			pass: func(a *assert.Assertions) { a.Greater(2, 1) },
+			fail: func(a *assert.Assertions) { a.Greater(uint8(1), uint8(2)) },
+		},
+		{
+			name: "Greater - different types",
+			pass: func(a *assert.Assertions) { a.Greater(2.5, 1.0) },
+			fail: func(a *assert.Assertions) { a.Greater(2, 1.0) },
+		},
+		{
+			name: "GreaterOrEqual",
+			pass: func(a *assert.Assertions) { a.GreaterOrEqual("b", "b") },
+			fail: func(a *assert.Assertions) { a.GreaterOrEqual("a", "b") },
+		},
+		{
+			name: "Less",
+			pass: func(a *assert.Assertions) { a.Less(time.Second, time.Minute) },
+			fail: func(a *assert.Assertions) { a.Less(1, 1) },
+		},
+		{
+			name: "LessOrEqual",
+			pass: func(a *assert.Assertions) { a.LessOrEqual(1, 1) },
+			fail: func(a *assert.Assertions) { a.LessOrEqual([]int{1}, []int{1}) },
+		},
+		{
+			name: "Positive",
+			pass: func(a *assert.Assertions) { a.Positive(uint(1)) },
+			fail: func(a *assert.Assertions) { a.Positive("1") },
+		},
+		{
+			name: "Negative",
+			pass: func(a *assert.Assertions) { a.Negative(-0.5) },
+			fail: func(a *assert.Assertions) { a.Negative(0) },
+		},
+		{
+			name: "InDelta",
+			pass: func(a *assert.Assertions) { a.InDelta(10, 10.5, 1) },
+			fail: func(a *assert.Assertions) { a.InDelta(10, 12, 1) },
+		},
+		{
+			name: "InEpsilon",
+			pass: func(a *assert.Assertions) { a.InEpsilon(100, uint(101), 0.02) },
+			fail: func(a *assert.Assertions) { a.InEpsilon(nil, 1, 0.02) },
+		},
+		{
+			name: "InDeltaSlice",
+			pass: func(a *assert.Assertions) { a.InDeltaSlice([]int{1, 2}, [2]float64{1.1, 2.1}, 0.2) },
+			fail: func(a *assert.Assertions) { a.InDeltaSlice([]int{1, 2}, []float64{1.1, 2.5}, 0.2) },
+		},
+		{
+			name: "InDeltaSlice - not a slice",
+			pass: func(a *assert.Assertions) { a.InDeltaSlice([]int{}, []int{}, 0) },
+			fail: func(a *assert.Assertions) { a.InDeltaSlice([]int{1}, 1, 0.2) },
+		},
+		{
+			name: "InDeltaSlice - not numeric",
+			pass: func(a *assert.Assertions) { a.InDeltaSlice([]int8{1}, []int16{1}, 0) },
+			fail: func(a *assert.Assertions) { a.InDeltaSlice([]string{"1"}, []int{1}, 0.2) },
+		},
+		{
+			name: "ElementsMatch",
+			pass: func(a *assert.Assertions) { a.ElementsMatch([]string{"a", "b"}, []string{"b", "a"}) },
+			fail: func(a *assert.Assertions) { a.ElementsMatch([]string{"a", "b"}, "ab") },
+		},
+		{
+			name: "Subset",
+			pass: func(a *assert.Assertions) { a.Subset([]int{1, 2, 3}, []int{3}) },
+			fail: func(a *assert.Assertions) { a.Subset([]int{1, 2, 3}, []int{4}) },
+		},
+		{
+			name: "NotSubset",
+			pass: func(a *assert.Assertions) { a.NotSubset([]int{1, 2, 3}, []int{4}) },
+			fail: func(a *assert.Assertions) { a.NotSubset(1, []int{4}) },
+		},
+		{
+			name: "Contains",
+			pass: func(a *assert.Assertions) { a.Contains([2]int{1, 2}, 2) },
+			fail: func(a *assert.Assertions) { a.Contains([]int{1, 2}, int64(2)) },
+		},
+		{
+			name: "Contains - invalid list",
+			pass: func(a *assert.Assertions) { a.NotContains([]string{"a"}, "b") },
+			fail: func(a *assert.Assertions) { a.NotContains("ab", "b") },
+		},
+		{
+			name: "Contains - not comparable",
+			pass: func(a *assert.Assertions) { a.NotContains([]any{1}, 2) },
+			fail: func(a *assert.Assertions) { a.Contains([][]int{{1}}, []int{1}) },
+		},
+		{
+			name: "ContainsString",
+			pass: func(a *assert.Assertions) { a.ContainsString("test", "es") },
+			fail: func(a *assert.Assertions) { a.NotContainsString("test", "es") },
+		},
+		{
+			name: "ContainsKey",
+			pass: func(a *assert.Assertions) { a.ContainsKey(map[int]bool{1: false}, 1) },
+			fail: func(a *assert.Assertions) { a.NotContainsKey(map[int]bool{1: false}, 1) },
+		},
+		{
+			name: "ContainsKey - not a map",
+			pass: func(a *assert.Assertions) { a.NotContainsKey(map[string]int(nil), "a") },
+			fail: func(a *assert.Assertions) { a.ContainsKey([]string{"a"}, 0) },
+		},
+		{
+			name: "PanicValue",
+			pass: func(a *assert.Assertions) { a.PanicValue(func() { panic(1) }) },
+			fail: func(a *assert.Assertions) { a.PanicValue(func() {}) },
+		},
+		{
+			name: "Eventually",
+			pass: func(a *assert.Assertions) {
+				a.Eventually(func() bool { return true }, time.Second, time.Millisecond)
+			},
+			fail: func(a *assert.Assertions) {
+				a.Eventually(func() bool { return false }, 10*time.Millisecond, time.Millisecond)
+			},
+		},
+		{
+			name: "Exactly",
+			pass: func(a *assert.Assertions) { a.Exactly(1, 1) },
+			fail: func(a *assert.Assertions) { a.Exactly(1, int8(1)) },
+		},
+		{
+			name: "IsType",
+			pass: func(a *assert.Assertions) { a.IsType("", "test") },
+			fail: func(a *assert.Assertions) { a.IsType("", 1) },
+		},
+		{
+			name: "Implements",
+			pass: func(a *assert.Assertions) { a.Implements((*error)(nil), testError) },
+			fail: func(a *assert.Assertions) { a.Implements((*error)(nil), 1) },
+		},
+		{
+			name: "Implements - invalid interface",
+			pass: func(a *assert.Assertions) { a.Implements((*fmt.Stringer)(nil), time.Second) },
+			fail: func(a *assert.Assertions) { a.Implements(nil, testError) },
+		},
+		{
+			name: "Same",
+			pass: func(a *assert.Assertions) { a.Same(testError, testError) },
+			fail: func(a *assert.Assertions) { a.Same(testError, errors.New("test-error")) },
+		},
+		{
+			name: "Same - not pointers",
+			pass: func(a *assert.Assertions) { a.NotSame(&testTypedError{}, &testTypedError{}) },
+			fail: func(a *assert.Assertions) { a.Same(1, 1) },
+		},
+		{
+			name: "NotSame",
+			pass: func(a *assert.Assertions) { a.NotSame(testError, errors.New("test-error")) },
+			fail: func(a *assert.Assertions) { a.NotSame(testError, testError) },
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Run("pass", func(t *testing.T) {
+				hlp := &testingMock{}
+				tt.pass(assert.New(hlp))
+				assert.True(t, hlp.helper)
+				assert.False(t, hlp.error)
+			})
+			t.Run("fail", func(t *testing.T) {
+				hlp := &testingMock{}
+				tt.fail(assert.New(hlp))
+				assert.True(t, hlp.helper)
+				assert.True(t, hlp.error)
+			})
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assert
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Contains asserts that the slice contains the element
+func Contains[S ~[]E, E comparable](t TestingT, s S, contains E, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if slices.Contains(s, contains) {
+		return true
+	}
+
+	fail(t, msgAndArgs, "%#v does not contain %#v", s, contains)
+
+	return false
+}
+
+// NotContains is the inverse of Contains
+func NotContains[S ~[]E, E comparable](t TestingT, s S, contains E, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if !slices.Contains(s, contains) {
+		return true
+	}
+
+	fail(t, msgAndArgs, "%#v should not contain %#v", s, contains)
+
+	return false
+}
+
+// ContainsString asserts that the string contains the substring,
+// testify does the same check with Contains
+func ContainsString(t TestingT, s, substr string, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if strings.Contains(s, substr) {
+		return true
+	}
+
+	fail(t, msgAndArgs, "%#v does not contain %#v", s, substr)
+
+	return false
+}
+
+// NotContainsString is the inverse of ContainsString
+func NotContainsString(t TestingT, s, substr string, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if !strings.Contains(s, substr) {
+		return true
+	}
+
+	fail(t, msgAndArgs, "%#v should not contain %#v", s, substr)
+
+	return false
+}
+
+// ContainsKey asserts that the map contains the key,
+// testify does the same check with Contains
+func ContainsKey[M ~map[K]V, K comparable, V any](t TestingT, m M, key K, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if _, found := m[key]; found {
+		return true
+	}
+
+	fail(t, msgAndArgs, "%#v does not contain key %#v", m, key)
+
+	return false
+}
+
+// NotContainsKey is the inverse of ContainsKey
+func NotContainsKey[M ~map[K]V, K comparable, V any](t TestingT, m M, key K, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if _, found := m[key]; !found {
+		return true
+	}
+
+	fail(t, msgAndArgs, "%#v should not contain key %#v", m, key)
+
+	return false
+}
+
+// containsDeep checks if the list contains an element deeply equal to e
+func containsDeep[S ~[]E, E any](list S, e E) bool {
+	return slices.ContainsFunc(list, func(o E) bool { return reflect.DeepEqual(o, e) })
+}
+
+// missingElements returns elements of list that are not matched by elements of
+// other, each element of other can only be matched once
+func missingElements[S ~[]E, E any](list, other S) (missing S) {
+	used := make([]bool, len(other))
+	for _, e := range list {
+		found := false
+		for j, o := range other {
+			if !used[j] && reflect.DeepEqual(e, o) {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, e)
+		}
+	}
+	return missing
+}
+
+// ElementsMatch asserts that both lists contain the same elements
+// ignoring the order, the number of duplicates must match
+func ElementsMatch[S ~[]E, E any](t TestingT, listA, listB S, msgAndArgs ...any) bool {
+	t.Helper()
+
+	extraA := missingElements(listA, listB)
+	extraB := missingElements(listB, listA)
+	if len(extraA) == 0 && len(extraB) == 0 {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Elements differ, extra elements in A: %+v, extra elements in B: %+v", extraA, extraB)
+
+	return false
+}
+
+// Subset asserts that all elements of subset are in the list,
+// the number of duplicates is ignored
+func Subset[S ~[]E, E any](t TestingT, list, subset S, msgAndArgs ...any) bool {
+	t.Helper()
+
+	for _, e := range subset {
+		if !containsDeep(list, e) {
+			fail(t, msgAndArgs, "%+v does not contain %+v", list, e)
+			return false
+		}
+	}
+
+	return true
+}
+
+// NotSubset asserts that at least one element of subset is not in the list
+func NotSubset[S ~[]E, E any](t TestingT, list, subset S, msgAndArgs ...any) bool {
+	t.Helper()
+
+	for _, e := range subset {
+		if !containsDeep(list, e) {
+			return true
+		}
+	}
+
+	fail(t, msgAndArgs, "%+v is a subset of %+v", subset, list)
+
+	return false
+}
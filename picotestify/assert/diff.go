@@ -0,0 +1,394 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assert
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	diffContextLines = 3
+
+	// Above this size the LCS table would get too large,
+	// all lines are then reported as changed
+	diffMaxLines = 2000
+)
+
+var (
+	formatterType = reflect.TypeFor[fmt.Formatter]()
+	stringerType  = reflect.TypeFor[fmt.Stringer]()
+	errorType     = reflect.TypeFor[error]()
+)
+
+// diff returns unified diff between expected and actual values,
+// an empty string is returned if values are too simple for the diff to be useful
+func diff(expected, actual any) string {
+	var e, a []string
+
+	es, eIsString := expected.(string)
+	as, aIsString := actual.(string)
+	if eIsString && aIsString {
+		e, a = strings.Split(es, "\n"), strings.Split(as, "\n")
+	} else {
+		e, a = renderLines(expected), renderLines(actual)
+	}
+
+	if len(e) <= 1 && len(a) <= 1 {
+		return ""
+	}
+
+	return "\n\nDiff:\n--- Expected\n+++ Actual\n" + unifiedDiff(e, a)
+}
+
+// renderLines renders the value structurally, one field or element per line
+func renderLines(v any) []string {
+	return renderValueLines(reflect.ValueOf(v))
+}
+
+func renderValueLines(v reflect.Value) []string {
+	r := renderer{visited: map[uintptr]bool{}}
+	r.render(v, 0)
+	r.lines = append(r.lines, r.current.String())
+	return r.lines
+}
+
+type renderer struct {
+	lines   []string
+	current strings.Builder
+	visited map[uintptr]bool
+}
+
+func (r *renderer) write(s string) { r.current.WriteString(s) }
+
+func (r *renderer) newLine(depth int) {
+	r.lines = append(r.lines, r.current.String())
+	r.current.Reset()
+	r.current.WriteString(strings.Repeat("  ", depth))
+}
+
+func (r *renderer) render(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		r.write("nil")
+		return
+	}
+	if r.renderFormatted(v) {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		r.renderPointer(v, depth)
+	case reflect.Interface:
+		if v.IsNil() {
+			r.write("nil")
+			return
+		}
+		r.render(v.Elem(), depth)
+	case reflect.Struct:
+		r.renderComposite(v.Type().String(), v.NumField(), depth, func(i int) {
+			r.write(v.Type().Field(i).Name + ": ")
+			r.render(v.Field(i), depth+1)
+		})
+	case reflect.Slice, reflect.Array:
+		r.renderList(v, depth)
+	case reflect.Map:
+		r.renderMap(v, depth)
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		if v.IsNil() {
+			r.write("(" + v.Type().String() + ")(nil)")
+		} else {
+			r.write(fmt.Sprintf("(%s)(%#x)", v.Type(), v.Pointer()))
+		}
+	default:
+		r.write(renderScalar(v))
+	}
+}
+
+// renderFormatted renders values that control their own text form (such as
+// redacted secrets) through fmt instead of walking their fields
+func (r *renderer) renderFormatted(v reflect.Value) bool {
+	t := v.Type()
+	if !t.Implements(formatterType) && !t.Implements(stringerType) && !t.Implements(errorType) {
+		return false
+	}
+
+	switch {
+	case isNilable(v.Kind()) && v.IsNil():
+		return false
+	case !v.CanInterface():
+		// Methods can not be called on values from unexported fields,
+		// the content is not shown since those methods could be hiding it
+		r.write(t.String() + "(unexported)")
+	default:
+		r.write(fmt.Sprintf("%+v", v.Interface()))
+	}
+	return true
+}
+
+func isNilable(k reflect.Kind) bool {
+	switch k {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *renderer) renderPointer(v reflect.Value, depth int) {
+	if v.IsNil() {
+		r.write("(" + v.Type().String() + ")(nil)")
+		return
+	}
+	if r.visited[v.Pointer()] {
+		r.write("<cycle " + v.Type().String() + ">")
+		return
+	}
+	r.visited[v.Pointer()] = true
+	defer delete(r.visited, v.Pointer())
+
+	r.write("&")
+	r.render(v.Elem(), depth)
+}
+
+// renderComposite renders n elements between braces, one element per line
+func (r *renderer) renderComposite(typeName string, n, depth int, renderElem func(i int)) {
+	r.write(typeName + "{")
+	for i := range n {
+		r.newLine(depth + 1)
+		renderElem(i)
+		r.write(",")
+	}
+	if n > 0 {
+		r.newLine(depth)
+	}
+	r.write("}")
+}
+
+func (r *renderer) renderList(v reflect.Value, depth int) {
+	if v.Kind() == reflect.Slice && v.IsNil() {
+		r.write(v.Type().String() + "(nil)")
+		return
+	}
+	if v.Type().Elem().Kind() == reflect.Uint8 {
+		r.renderBytes(v, depth)
+		return
+	}
+	r.renderComposite(v.Type().String(), v.Len(), depth, func(i int) {
+		r.render(v.Index(i), depth+1)
+	})
+}
+
+func (r *renderer) renderMap(v reflect.Value, depth int) {
+	if v.IsNil() {
+		r.write(v.Type().String() + "(nil)")
+		return
+	}
+
+	// Keys are sorted by their rendered form to get stable output
+	type entry struct {
+		key string
+		val reflect.Value
+	}
+	entries := []entry{}
+	for iter := v.MapRange(); iter.Next(); {
+		key := strings.Join(renderValueLines(iter.Key()), " ")
+		entries = append(entries, entry{key: key, val: iter.Value()})
+	}
+	slices.SortFunc(entries, func(a, b entry) int { return strings.Compare(a.key, b.key) })
+
+	r.renderComposite(v.Type().String(), len(entries), depth, func(i int) {
+		r.write(entries[i].key + ": ")
+		r.render(entries[i].val, depth+1)
+	})
+}
+
+// renderBytes renders byte slices and arrays as a hex dump
+func (r *renderer) renderBytes(v reflect.Value, depth int) {
+	data := make([]byte, v.Len())
+	for i := range data {
+		data[i] = byte(v.Index(i).Uint())
+	}
+
+	r.write(v.Type().String() + "{")
+	for line := range strings.Lines(hex.Dump(data)) {
+		r.newLine(depth + 1)
+		r.write(strings.TrimSuffix(line, "\n"))
+	}
+	if len(data) > 0 {
+		r.newLine(depth)
+	}
+	r.write("}")
+}
+
+func renderScalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits())
+	default:
+		return v.Type().String()
+	}
+}
+
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// diffOps calculates the line diff using the longest common subsequence
+func diffOps(e, a []string) []diffOp {
+	// Common prefix and suffix are handled separately to reduce the LCS table size
+	prefix := 0
+	for prefix < len(e) && prefix < len(a) && e[prefix] == a[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(e)-prefix && suffix < len(a)-prefix && e[len(e)-1-suffix] == a[len(a)-1-suffix] {
+		suffix++
+	}
+
+	ops := appendOps(nil, ' ', e[:prefix])
+
+	em, am := e[prefix:len(e)-suffix], a[prefix:len(a)-suffix]
+	if len(em) > diffMaxLines || len(am) > diffMaxLines {
+		ops = appendOps(ops, '-', em)
+		ops = appendOps(ops, '+', am)
+	} else {
+		ops = append(ops, lcsDiffOps(em, am)...)
+	}
+
+	return appendOps(ops, ' ', e[len(e)-suffix:])
+}
+
+func appendOps(ops []diffOp, kind byte, lines []string) []diffOp {
+	for _, l := range lines {
+		ops = append(ops, diffOp{kind, l})
+	}
+	return ops
+}
+
+// lcsDiffOps calculates the minimal line diff, the LCS table
+// is quadratic in size thus this should only be used for small inputs
+func lcsDiffOps(e, a []string) []diffOp {
+	// lcs[i][j] is the length of LCS of e[i:] and a[j:]
+	lcs := make([][]int, len(e)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(a)+1)
+	}
+	for i := len(e) - 1; i >= 0; i-- {
+		for j := len(a) - 1; j >= 0; j-- {
+			if e[i] == a[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := []diffOp{}
+	i, j := 0, 0
+	for i < len(e) || j < len(a) {
+		switch {
+		case i < len(e) && j < len(a) && e[i] == a[j]:
+			ops = append(ops, diffOp{' ', e[i]})
+			i++
+			j++
+		case j >= len(a) || (i < len(e) && lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{'-', e[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', a[j]})
+			j++
+		}
+	}
+	return ops
+}
+
+// unifiedDiff renders the diff in the unified format with hunks of changes
+// surrounded by context lines
+func unifiedDiff(e, a []string) string {
+	ops := diffOps(e, a)
+
+	sb := strings.Builder{}
+	for start := 0; ; {
+		hunkStart, hunkEnd, found := nextHunk(ops, start)
+		if !found {
+			break
+		}
+
+		eLine, aLine := countLines(ops[:hunkStart])
+		eCount, aCount := countLines(ops[hunkStart:hunkEnd])
+
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", eLine+1, eCount, aLine+1, aCount)
+		for _, op := range ops[hunkStart:hunkEnd] {
+			sb.WriteByte(op.kind)
+			sb.WriteString(op.line)
+			sb.WriteByte('\n')
+		}
+
+		start = hunkEnd
+	}
+
+	return sb.String()
+}
+
+// nextHunk finds the range of operations of the next hunk starting at given position,
+// the hunk contains changes close enough to each other along with their context lines
+func nextHunk(ops []diffOp, start int) (hunkStart, hunkEnd int, found bool) {
+	for start < len(ops) && ops[start].kind == ' ' {
+		start++
+	}
+	if start == len(ops) {
+		return 0, 0, false
+	}
+
+	end := start
+	for i := start; i < len(ops) && i <= end+2*diffContextLines; i++ {
+		if ops[i].kind != ' ' {
+			end = i
+		}
+	}
+
+	return max(0, start-diffContextLines), min(len(ops), end+diffContextLines+1), true
+}
+
+// countLines returns the number of expected and actual lines covered by operations
+func countLines(ops []diffOp) (eCount, aCount int) {
+	for _, op := range ops {
+		if op.kind != '+' {
+			eCount++
+		}
+		if op.kind != '-' {
+			aCount++
+		}
+	}
+	return eCount, aCount
+}
@@ -0,0 +1,201 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type capturingT struct{ messages []string }
+
+func (t *capturingT) Helper() {}
+func (t *capturingT) Error(msgAndArgs ...any) {
+	t.messages = append(t.messages, fmt.Sprint(msgAndArgs...))
+}
+
+type diffTestInner struct {
+	Values []int
+	Bytes  []byte
+}
+
+type diffTestStruct struct {
+	Name   string
+	Inner  *diffTestInner
+	Labels map[string]int
+	hidden bool
+}
+
+// diffTestSecret hides its content just like redacted blob keys do
+type diffTestSecret struct{ data []byte }
+
+func (s *diffTestSecret) String() string { return "diffTestSecret(redacted)" }
+
+type diffTestWithSecrets struct {
+	Secret   *diffTestSecret
+	NoSecret *diffTestSecret
+	Err      error
+	secret   *diffTestSecret
+}
+
+type diffTestCycle struct {
+	Next *diffTestCycle
+}
+
+func TestRenderLines(t *testing.T) {
+	cycle := &diffTestCycle{}
+	cycle.Next = cycle
+
+	for _, tc := range []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{"nil", nil, "nil"},
+		{"int", 42, "42"},
+		{"string", "a\"b", `"a\"b"`},
+		{"nil pointer", (*int)(nil), "(*int)(nil)"},
+		{"nil slice", []int(nil), "[]int(nil)"},
+		{"empty struct", struct{}{}, "struct {}{}"},
+		{"float", 1.5, "1.5"},
+		{
+			"struct",
+			diffTestStruct{
+				Name:   "test",
+				Inner:  &diffTestInner{Values: []int{1, 2}, Bytes: []byte("0123456789abcdefXYZ")},
+				Labels: map[string]int{"b": 2, "a": 1},
+			},
+			`assert.diffTestStruct{
+  Name: "test",
+  Inner: &assert.diffTestInner{
+    Values: []int{
+      1,
+      2,
+    },
+    Bytes: []uint8{
+      00000000  30 31 32 33 34 35 36 37  38 39 61 62 63 64 65 66  |0123456789abcdef|
+      00000010  58 59 5a                                          |XYZ|
+    },
+  },
+  Labels: map[string]int{
+    "a": 1,
+    "b": 2,
+  },
+  hidden: false,
+}`,
+		},
+		{
+			"secrets",
+			diffTestWithSecrets{
+				Secret: &diffTestSecret{data: []byte("top secret")},
+				Err:    fmt.Errorf("some error"),
+				secret: &diffTestSecret{data: []byte("top secret")},
+			},
+			`assert.diffTestWithSecrets{
+  Secret: diffTestSecret(redacted),
+  NoSecret: (*assert.diffTestSecret)(nil),
+  Err: some error,
+  secret: *assert.diffTestSecret(unexported),
+}`,
+		},
+		{
+			"cycle",
+			cycle,
+			`&assert.diffTestCycle{
+  Next: <cycle *assert.diffTestCycle>,
+}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rendered := strings.Join(renderLines(tc.value), "\n")
+			if rendered != tc.expected {
+				t.Errorf("unexpected rendering:\n%s\nexpected:\n%s", rendered, tc.expected)
+			}
+		})
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		e, a     []string
+		expected string
+	}{
+		{
+			"single change",
+			[]string{"a", "b", "c"},
+			[]string{"a", "x", "c"},
+			"@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n",
+		},
+		{
+			"insertion and removal",
+			[]string{"a", "b", "c", "d"},
+			[]string{"b", "c", "x", "d"},
+			"@@ -1,4 +1,4 @@\n-a\n b\n c\n+x\n d\n",
+		},
+		{
+			"separate hunks",
+			[]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+			[]string{"x", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "y"},
+			"@@ -1,4 +1,4 @@\n-1\n+x\n 2\n 3\n 4\n@@ -9,4 +9,4 @@\n 9\n 10\n 11\n-12\n+y\n",
+		},
+		{
+			"no changes",
+			[]string{"a", "b"},
+			[]string{"a", "b"},
+			"",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := unifiedDiff(tc.e, tc.a)
+			if d != tc.expected {
+				t.Errorf("unexpected diff:\n%s\nexpected:\n%s", d, tc.expected)
+			}
+		})
+	}
+}
+
+func TestEqualFailureContainsDiff(t *testing.T) {
+	t.Run("struct", func(t *testing.T) {
+		mock := &capturingT{}
+		Equal(mock,
+			diffTestInner{Values: []int{1, 2, 3}},
+			diffTestInner{Values: []int{1, 5, 3}},
+		)
+		if len(mock.messages) != 1 || !strings.Contains(mock.messages[0], "\n-    2,\n+    5,\n") {
+			t.Errorf("diff not found in the message: %v", mock.messages)
+		}
+	})
+
+	t.Run("multiline string", func(t *testing.T) {
+		mock := &capturingT{}
+		Equal(mock, "line1\nline2", "line1\nline3")
+		expectedDiff := "--- Expected\n+++ Actual\n@@ -1,2 +1,2 @@\n line1\n-line2\n+line3\n"
+		if len(mock.messages) != 1 || !strings.Contains(mock.messages[0], expectedDiff) {
+			t.Errorf("diff not found in the message: %v", mock.messages)
+		}
+	})
+
+	t.Run("simple values", func(t *testing.T) {
+		mock := &capturingT{}
+		Equal(mock, 1, 2)
+		if len(mock.messages) != 1 || mock.messages[0] != "Values not equal, expected: 1, actual: 2" {
+			t.Errorf("unexpected message: %v", mock.messages)
+		}
+	})
+}
@@ -0,0 +1,124 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assert
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// jsonDiff returns the description of the first difference between decoded
+// JSON values, an empty string is returned if values are equal
+func jsonDiff(path string, expected, actual any) string {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			break
+		}
+
+		keys := slices.Sorted(maps.Keys(e))
+		for _, k := range keys {
+			av, found := a[k]
+			if !found {
+				return fmt.Sprintf("%s: missing key %q", path, k)
+			}
+			if d := jsonDiff(path+"."+jsonPathKey(k), e[k], av); d != "" {
+				return d
+			}
+		}
+		for _, k := range slices.Sorted(maps.Keys(a)) {
+			if _, found := e[k]; !found {
+				return fmt.Sprintf("%s: unexpected key %q", path, k)
+			}
+		}
+		return ""
+
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			break
+		}
+
+		for i := range min(len(e), len(a)) {
+			if d := jsonDiff(fmt.Sprintf("%s[%d]", path, i), e[i], a[i]); d != "" {
+				return d
+			}
+		}
+		if len(e) != len(a) {
+			return fmt.Sprintf("%s: expected %d elements, got %d", path, len(e), len(a))
+		}
+		return ""
+
+	default:
+		if expected == actual {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("%s: expected %s, got %s", path, jsonString(expected), jsonString(actual))
+}
+
+// jsonPathKey returns the key in a form suitable for the path, keys that are not
+// simple identifiers are quoted
+func jsonPathKey(k string) string {
+	for i, c := range k {
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (i == 0 || c < '0' || c > '9') {
+			return strconv.Quote(k)
+		}
+	}
+	if k == "" {
+		return `""`
+	}
+	return k
+}
+
+func jsonString(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// JSONEq asserts that both strings contain semantically equal JSON documents,
+// the order of object keys and formatting is ignored
+func JSONEq(t TestingT, expected, actual string, msgAndArgs ...any) bool {
+	t.Helper()
+
+	var e, a any
+	if err := json.Unmarshal([]byte(expected), &e); err != nil {
+		fail(t, msgAndArgs, "Expected value is not a valid JSON: %v", err)
+		return false
+	}
+	if err := json.Unmarshal([]byte(actual), &a); err != nil {
+		fail(t, msgAndArgs, "Actual value is not a valid JSON: %v", err)
+		return false
+	}
+
+	d := jsonDiff("$", e, a)
+	if d == "" {
+		return true
+	}
+
+	fail(t, msgAndArgs, "JSON documents not equal, first difference at %s", d)
+
+	return false
+}
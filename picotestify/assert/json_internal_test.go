@@ -0,0 +1,46 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assert
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONDiff(t *testing.T) {
+	for _, tt := range []struct {
+		expected string
+		actual   string
+		diff     string
+	}{
+		{`{"a": {"b": [1, 2]}}`, `{"a": {"b": [1, 2]}}`, ``},
+		{`{"a": {"b": [1, 2]}}`, `{"a": {"b": [1, 3]}}`, `$.a.b[1]: expected 2, got 3`},
+		{`{"a": {"b": [1, 2]}}`, `{"a": {"b": [1]}}`, `$.a.b: expected 2 elements, got 1`},
+		{`{"a": 1, "b": 2}`, `{"a": 1}`, `$: missing key "b"`},
+		{`{"a": 1}`, `{"a": 1, "c": 2}`, `$: unexpected key "c"`},
+		{`{"a b": {"x1": "y"}}`, `{"a b": {"x1": "z"}}`, `$."a b".x1: expected "y", got "z"`},
+		{`{"a": [1]}`, `{"a": {"0": 1}}`, `$.a: expected [1], got {"0":1}`},
+		{`{"": null}`, `{"": false}`, `$."": expected null, got false`},
+	} {
+		t.Run(tt.expected+" "+tt.actual, func(t *testing.T) {
+			var e, a any
+			Equal(t, nil, json.Unmarshal([]byte(tt.expected), &e))
+			Equal(t, nil, json.Unmarshal([]byte(tt.actual), &a))
+			Equal(t, tt.diff, jsonDiff("$", e, a))
+		})
+	}
+}
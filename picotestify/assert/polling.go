@@ -0,0 +1,186 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Polling stops that much before the test deadline so that the failure can still be reported
+const pollDeadlineMargin = 100 * time.Millisecond
+
+var errPollTimeout = errors.New("condition wait time elapsed")
+
+// pollContext returns the context limiting the polling time, the context is derived
+// from the test context and test deadline if those are supported by the TestingT
+func pollContext(t TestingT, waitFor time.Duration) (context.Context, context.CancelFunc) {
+	ctx := context.Background()
+	if tc, ok := t.(interface{ Context() context.Context }); ok {
+		ctx = tc.Context()
+	}
+
+	ctx, cancelTimeout := context.WithTimeoutCause(ctx, waitFor, errPollTimeout)
+
+	if td, ok := t.(interface{ Deadline() (time.Time, bool) }); ok {
+		if deadline, ok := td.Deadline(); ok {
+			ctx, cancelDeadline := context.WithDeadline(ctx, deadline.Add(-pollDeadlineMargin))
+			return ctx, func() { cancelDeadline(); cancelTimeout() }
+		}
+	}
+
+	return ctx, cancelTimeout
+}
+
+// poll calls the check function immediately and then on every tick until
+// it returns true or the context is done, the check is done synchronously
+// thus no goroutines are left running after poll returns
+func poll(ctx context.Context, tick time.Duration, check func() bool) bool {
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	for {
+		if check() {
+			return true
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollInterrupted returns the reason for the polling to be stopped before the wait time elapsed
+func pollInterrupted(ctx context.Context) error {
+	if cause := context.Cause(ctx); !errors.Is(cause, errPollTimeout) {
+		return cause
+	}
+	return nil
+}
+
+// Eventually asserts that the condition returns true within waitFor time,
+// the condition is checked every tick
+func Eventually(t TestingT, condition func() bool, waitFor, tick time.Duration, msgAndArgs ...any) bool {
+	t.Helper()
+
+	ctx, cancel := pollContext(t, waitFor)
+	defer cancel()
+
+	if poll(ctx, tick, condition) {
+		return true
+	}
+
+	if err := pollInterrupted(ctx); err != nil {
+		fail(t, msgAndArgs, "Condition not satisfied, waiting interrupted: %v", err)
+	} else {
+		fail(t, msgAndArgs, "Condition never satisfied within %v", waitFor)
+	}
+
+	return false
+}
+
+// Never asserts that the condition does not return true for waitFor time,
+// the condition is checked every tick
+func Never(t TestingT, condition func() bool, waitFor, tick time.Duration, msgAndArgs ...any) bool {
+	t.Helper()
+
+	ctx, cancel := pollContext(t, waitFor)
+	defer cancel()
+
+	if poll(ctx, tick, condition) {
+		fail(t, msgAndArgs, "Condition satisfied")
+		return false
+	}
+
+	if err := pollInterrupted(ctx); err != nil {
+		fail(t, msgAndArgs, "Waiting interrupted: %v", err)
+		return false
+	}
+
+	return true
+}
+
+// CollectT gathers assertion failures for a single tick of EventuallyWithT
+type CollectT struct {
+	errors []string
+}
+
+// collectFailNow is used to stop the condition function when FailNow is called
+type collectFailNow struct{}
+
+func (c *CollectT) Helper() {}
+
+func (c *CollectT) Error(msgAndArgs ...any) {
+	c.errors = append(c.errors, fmt.Sprint(msgAndArgs...))
+}
+
+// FailNow stops execution of the condition function for the current tick
+func (c *CollectT) FailNow() {
+	c.errors = append(c.errors, "FailNow called")
+	panic(collectFailNow{})
+}
+
+func (c *CollectT) failed() bool { return len(c.errors) > 0 }
+
+// run executes the condition function, stopping on FailNow
+func (c *CollectT) run(condition func(collect *CollectT)) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(collectFailNow); !ok {
+				panic(r)
+			}
+		}
+	}()
+	condition(c)
+}
+
+// EventuallyWithT asserts that the condition does not report any failures
+// to the CollectT within waitFor time, the condition is checked every tick.
+//
+// Failures from the last check are reported if the condition was never satisfied.
+func EventuallyWithT(
+	t TestingT, condition func(collect *CollectT), waitFor, tick time.Duration, msgAndArgs ...any,
+) bool {
+	t.Helper()
+
+	ctx, cancel := pollContext(t, waitFor)
+	defer cancel()
+
+	var last *CollectT
+	if poll(ctx, tick, func() bool {
+		last = &CollectT{}
+		last.run(condition)
+		return !last.failed()
+	}) {
+		return true
+	}
+
+	for _, e := range last.errors {
+		t.Error(e)
+	}
+	if err := pollInterrupted(ctx); err != nil {
+		fail(t, msgAndArgs, "Condition not satisfied, waiting interrupted: %v", err)
+	} else {
+		fail(t, msgAndArgs, "Condition never satisfied within %v", waitFor)
+	}
+
+	return false
+}
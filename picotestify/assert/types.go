@@ -0,0 +1,168 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assert
+
+import (
+	"reflect"
+)
+
+// Exactly asserts that values are equal and are of the same type
+func Exactly(t TestingT, expected, actual any, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		fail(t, msgAndArgs, "Types not equal, expected: %T, actual: %T", expected, actual)
+		return false
+	}
+
+	return Equal(t, expected, actual, msgAndArgs...)
+}
+
+// convertLossless converts the value to given type, the conversion
+// must not lose any information, e.g. by truncating a float
+func convertLossless(v reflect.Value, to reflect.Type) (reflect.Value, bool) {
+	if !v.Type().ConvertibleTo(to) || !to.ConvertibleTo(v.Type()) {
+		return reflect.Value{}, false
+	}
+
+	// Integer to string conversion creates a rune, not a decimal representation
+	if to.Kind() == reflect.String && v.Kind() != reflect.String {
+		return reflect.Value{}, false
+	}
+
+	// Conversion of a slice to a shorter array panics
+	if v.Kind() == reflect.Slice && to.Kind() == reflect.Array && v.Len() < to.Len() {
+		return reflect.Value{}, false
+	}
+
+	converted := v.Convert(to)
+	if !reflect.DeepEqual(converted.Convert(v.Type()).Interface(), v.Interface()) {
+		return reflect.Value{}, false
+	}
+
+	return converted, true
+}
+
+func objectsAreEqualValues(expected, actual any) bool {
+	if reflect.DeepEqual(expected, actual) {
+		return true
+	}
+
+	ev, av := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if !ev.IsValid() || !av.IsValid() {
+		return false
+	}
+
+	if c, ok := convertLossless(ev, av.Type()); ok && reflect.DeepEqual(c.Interface(), actual) {
+		return true
+	}
+	if c, ok := convertLossless(av, ev.Type()); ok && reflect.DeepEqual(c.Interface(), expected) {
+		return true
+	}
+
+	return false
+}
+
+// EqualValues asserts that values are equal after converting them
+// to the same type, only conversions that do not lose information are done
+func EqualValues(t TestingT, expected, actual any, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if objectsAreEqualValues(expected, actual) {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Values not equal, expected: %#v, actual: %#v", expected, actual)
+
+	return false
+}
+
+// IsType asserts that the object is of the same type as expectedType
+func IsType(t TestingT, expectedType, object any, msgAndArgs ...any) bool {
+	t.Helper()
+
+	if reflect.TypeOf(expectedType) == reflect.TypeOf(object) {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Object expected to be of type %T, but was %T", expectedType, object)
+
+	return false
+}
+
+// implements checks if the object implements the interface type
+func implements(t TestingT, interfaceType reflect.Type, object any, msgAndArgs []any) bool {
+	t.Helper()
+
+	if interfaceType.Kind() != reflect.Interface {
+		fail(t, msgAndArgs, "%v is not an interface", interfaceType)
+		return false
+	}
+
+	if object != nil && reflect.TypeOf(object).Implements(interfaceType) {
+		return true
+	}
+
+	fail(t, msgAndArgs, "%T must implement %v", object, interfaceType)
+
+	return false
+}
+
+// Implements asserts that the object implements the interface I
+//
+// Note: the signature differs from testify where the interface
+// is passed as a nil pointer to the interface type.
+func Implements[I any](t TestingT, object any, msgAndArgs ...any) bool {
+	t.Helper()
+	return implements(t, reflect.TypeFor[I](), object, msgAndArgs)
+}
+
+// Same asserts that both pointers point to the same object
+func Same[T any](t TestingT, expected, actual *T, msgAndArgs ...any) bool {
+	t.Helper()
+	return same(t, expected == actual, expected, actual, msgAndArgs)
+}
+
+// NotSame asserts that pointers point to different objects
+func NotSame[T any](t TestingT, expected, actual *T, msgAndArgs ...any) bool {
+	t.Helper()
+	return notSame(t, expected == actual, actual, msgAndArgs)
+}
+
+func same(t TestingT, isSame bool, expected, actual any, msgAndArgs []any) bool {
+	t.Helper()
+
+	if isSame {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Not same, expected: %p, actual: %p", expected, actual)
+
+	return false
+}
+
+func notSame(t TestingT, isSame bool, actual any, msgAndArgs []any) bool {
+	t.Helper()
+
+	if !isSame {
+		return true
+	}
+
+	fail(t, msgAndArgs, "Expected different pointers, both are: %p", actual)
+
+	return false
+}
@@ -0,0 +1,315 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/cinode/go-common/picotestify/assert"
+)
+
+// Anything matches any argument value
+const Anything = "mock.Anything"
+
+// ArgumentMatcher matches arguments using a custom function, it is created with MatchedBy
+type ArgumentMatcher struct {
+	match func(arg any) bool
+	desc  string
+}
+
+// Matches checks if the argument is matched by the matcher
+func (m ArgumentMatcher) Matches(arg any) bool { return m.match(arg) }
+
+func (m ArgumentMatcher) String() string { return m.desc }
+
+// MatchedBy returns an argument matcher using given function, the argument
+// must be of type T, nil arguments are passed as zero value if T can be nil
+func MatchedBy[T any](fn func(T) bool) ArgumentMatcher {
+	tt := reflect.TypeFor[T]()
+	return ArgumentMatcher{
+		desc: fmt.Sprintf("MatchedBy(%v)", tt),
+		match: func(arg any) bool {
+			if arg == nil {
+				switch tt.Kind() {
+				case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+					var zero T
+					return fn(zero)
+				}
+				return false
+			}
+
+			v, ok := arg.(T)
+			return ok && fn(v)
+		},
+	}
+}
+
+// Arguments holds arguments of a call or values returned from it
+type Arguments []any
+
+func (a Arguments) Get(i int) any { return a[i] }
+
+func (a Arguments) String(i int) string { return a[i].(string) }
+func (a Arguments) Int(i int) int       { return a[i].(int) }
+func (a Arguments) Bool(i int) bool     { return a[i].(bool) }
+
+func (a Arguments) Error(i int) error {
+	if a[i] == nil {
+		return nil
+	}
+	return a[i].(error)
+}
+
+// matches checks if actual arguments match the expected ones
+func (a Arguments) matches(actual []any) bool {
+	if len(a) != len(actual) {
+		return false
+	}
+
+	for i, expected := range a {
+		switch e := expected.(type) {
+		case ArgumentMatcher:
+			if !e.Matches(actual[i]) {
+				return false
+			}
+		default:
+			if expected != Anything && !reflect.DeepEqual(expected, actual[i]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Call is a single expected call of a mocked method
+type Call struct {
+	parent *Mock
+
+	method     string
+	arguments  Arguments
+	returns    Arguments
+	runFn      func(args Arguments)
+	times      int // 0 means any number of calls
+	optional   bool
+	totalCalls int
+}
+
+// Return sets values returned from the call
+func (c *Call) Return(returnArguments ...any) *Call {
+	c.parent.mu.Lock()
+	defer c.parent.mu.Unlock()
+
+	c.returns = returnArguments
+	return c
+}
+
+// Run sets the function executed on each call with call arguments,
+// it can be used e.g. to fill in output parameters
+func (c *Call) Run(fn func(args Arguments)) *Call {
+	c.parent.mu.Lock()
+	defer c.parent.mu.Unlock()
+
+	c.runFn = fn
+	return c
+}
+
+// Times limits the number of calls matching this expectation,
+// AssertExpectations checks that exactly that number of calls was done
+func (c *Call) Times(n int) *Call {
+	c.parent.mu.Lock()
+	defer c.parent.mu.Unlock()
+
+	c.times = n
+	return c
+}
+
+func (c *Call) Once() *Call  { return c.Times(1) }
+func (c *Call) Twice() *Call { return c.Times(2) }
+
+// Maybe marks the call as optional, AssertExpectations does not fail if it was not done
+func (c *Call) Maybe() *Call {
+	c.parent.mu.Lock()
+	defer c.parent.mu.Unlock()
+
+	c.optional = true
+	return c
+}
+
+// On adds another expectation to the parent mock, allows chaining
+func (c *Call) On(methodName string, arguments ...any) *Call {
+	return c.parent.On(methodName, arguments...)
+}
+
+func (c *Call) exhausted() bool { return c.times > 0 && c.totalCalls >= c.times }
+
+func (c *Call) satisfied() bool {
+	switch {
+	case c.optional:
+		return true
+	case c.times > 0:
+		return c.totalCalls == c.times
+	default:
+		return c.totalCalls > 0
+	}
+}
+
+func (c *Call) String() string {
+	return fmt.Sprintf("%s(%s)", c.method, formatArguments(c.arguments))
+}
+
+func formatArguments(args []any) string {
+	parts := make([]string, len(args))
+	for i, a := range args {
+		switch a := a.(type) {
+		case ArgumentMatcher:
+			parts[i] = a.String()
+		default:
+			parts[i] = fmt.Sprintf("%#v", a)
+			if a == Anything {
+				parts[i] = Anything
+			}
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Mock records calls of mocked methods and matches them against expectations,
+// it is meant to be embedded in mock implementations of interfaces.
+//
+// All methods are safe for concurrent use.
+type Mock struct {
+	mu            sync.Mutex
+	expectedCalls []*Call
+}
+
+// On adds an expectation of a call to the method with given arguments,
+// arguments can also be Anything or matchers created with MatchedBy
+func (m *Mock) On(methodName string, arguments ...any) *Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c := &Call{parent: m, method: methodName, arguments: arguments}
+	m.expectedCalls = append(m.expectedCalls, c)
+	return c
+}
+
+// Called records the call of the mocked method and returns values set with Return,
+// the method name is taken from the caller.
+//
+// Calls not matching any expectation panic.
+func (m *Mock) Called(arguments ...any) Arguments {
+	// Same as in testify, the mocked method is recognized by the name of the calling function
+	pc, _, _, ok := runtime.Caller(1)
+	if !ok {
+		panic("mock: could not get the caller of Called")
+	}
+	return m.MethodCalled(methodName(runtime.FuncForPC(pc).Name()), arguments...)
+}
+
+// methodName extracts the method name from the full function name,
+// e.g. "pkg.(*myMock).Get-fm" or "pkg.(*genericMock[...]).Get" are turned into "Get"
+func methodName(funcName string) string {
+	funcName = strings.TrimSuffix(funcName, "-fm")
+	if strings.HasSuffix(funcName, "]") {
+		// Type arguments of a generic function, those may contain dots
+		funcName = funcName[:strings.LastIndex(funcName, "[")]
+	}
+	return funcName[strings.LastIndex(funcName, ".")+1:]
+}
+
+// MethodCalled records the call of the method with given name, see Called
+func (m *Mock) MethodCalled(methodName string, arguments ...any) Arguments {
+	m.mu.Lock()
+
+	var found, exhausted *Call
+	for _, c := range m.expectedCalls {
+		if c.method != methodName || !c.arguments.matches(arguments) {
+			continue
+		}
+		if c.exhausted() {
+			exhausted = c
+			continue
+		}
+		found = c
+		break
+	}
+
+	if found == nil {
+		m.mu.Unlock()
+		call := fmt.Sprintf("%s(%s)", methodName, formatArguments(arguments))
+		if exhausted != nil {
+			panic(fmt.Sprintf("mock: %s called more than expected %d times", call, exhausted.times))
+		}
+		panic(fmt.Sprintf("mock: unexpected call %s", call))
+	}
+
+	found.totalCalls++
+	runFn, returns := found.runFn, found.returns
+	m.mu.Unlock()
+
+	if runFn != nil {
+		runFn(arguments)
+	}
+
+	return returns
+}
+
+// AssertExpectations asserts that all expected calls were done,
+// calls marked with Maybe are not required
+func (m *Mock) AssertExpectations(t assert.TestingT) bool {
+	t.Helper()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ok := true
+	for _, c := range m.expectedCalls {
+		if c.satisfied() {
+			continue
+		}
+		ok = false
+		if c.times > 0 {
+			t.Error(fmt.Sprintf("Expected call %s %d times, called %d times", c, c.times, c.totalCalls))
+		} else {
+			t.Error(fmt.Sprintf("Expected call %s was not done", c))
+		}
+	}
+
+	return ok
+}
+
+// AssertNumberOfCalls asserts the number of calls done to the method
+func (m *Mock) AssertNumberOfCalls(t assert.TestingT, methodName string, expectedCalls int) bool {
+	t.Helper()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	calls := 0
+	for _, c := range m.expectedCalls {
+		if c.method == methodName {
+			calls += c.totalCalls
+		}
+	}
+
+	return assert.Equal(t, expectedCalls, calls, fmt.Sprintf("Number of calls to %s", methodName))
+}
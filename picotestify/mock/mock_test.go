@@ -0,0 +1,190 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cinode/go-common/picotestify/mock"
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+type testingMock struct {
+	helper bool
+	errors []string
+}
+
+func (t *testingMock) Helper() { t.helper = true }
+
+func (t *testingMock) Error(msgAndArgs ...any) {
+	t.errors = append(t.errors, msgAndArgs[0].(string))
+}
+
+type datastoreMock struct {
+	mock.Mock
+}
+
+func (d *datastoreMock) Get(ctx context.Context, key string) ([]byte, error) {
+	args := d.Called(ctx, key)
+	data, _ := args.Get(0).([]byte)
+	return data, args.Error(1)
+}
+
+func (d *datastoreMock) Put(ctx context.Context, key string, data []byte) error {
+	return d.Called(ctx, key, data).Error(0)
+}
+
+type genericMock[T any] struct {
+	mock.Mock
+}
+
+func (g *genericMock[T]) Get(key string) T {
+	return g.Called(key).Get(0).(T)
+}
+
+func TestGenericMock(t *testing.T) {
+	g := &genericMock[int]{}
+	g.On("Get", "a").Return(42)
+
+	require.Equal(t, 42, g.Get("a"))
+	require.True(t, g.AssertExpectations(t))
+}
+
+func TestMockReturn(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	hasPrefixB := mock.MatchedBy(func(k string) bool { return strings.HasPrefix(k, "b") })
+
+	d := &datastoreMock{}
+	d.On("Get", mock.Anything, "a").Return([]byte("data"), nil).
+		On("Get", mock.Anything, hasPrefixB).Return(nil, errNotFound)
+
+	data, err := d.Get(t.Context(), "a")
+	require.NoError(t, err)
+	require.Equal(t, []byte("data"), data)
+
+	data, err = d.Get(t.Context(), "b1")
+	require.ErrorIs(t, err, errNotFound)
+	require.Nil(t, data)
+
+	value := require.PanicValue(t, func() { d.Get(t.Context(), "c") })
+	require.ContainsString(t, fmt.Sprint(value), `mock: unexpected call Get(`)
+
+	require.True(t, d.AssertExpectations(t))
+	require.True(t, d.AssertNumberOfCalls(t, "Get", 2))
+}
+
+func TestMockTimes(t *testing.T) {
+	d := &datastoreMock{}
+	d.On("Put", mock.Anything, "a", []byte("1")).Return(nil).Once()
+	d.On("Put", mock.Anything, "a", mock.Anything).Return(errors.New("fallback")).Maybe()
+
+	require.NoError(t, d.Put(t.Context(), "a", []byte("1")))
+	require.EqualError(t, d.Put(t.Context(), "a", []byte("1")), "fallback")
+
+	d2 := &datastoreMock{}
+	d2.On("Put", mock.Anything, "a", mock.Anything).Return(nil).Twice()
+	require.NoError(t, d2.Put(t.Context(), "a", nil))
+	require.NoError(t, d2.Put(t.Context(), "a", nil))
+	require.Panics(t, func() { d2.Put(t.Context(), "a", nil) })
+	require.True(t, d2.AssertExpectations(t))
+}
+
+func TestMockAssertExpectations(t *testing.T) {
+	d := &datastoreMock{}
+	d.On("Get", mock.Anything, "a").Return(nil, nil)
+	d.On("Get", mock.Anything, "b").Return(nil, nil).Times(2)
+	d.On("Get", mock.Anything, "c").Return(nil, nil).Maybe()
+
+	d.Get(t.Context(), "b")
+
+	tm := &testingMock{}
+	require.False(t, d.AssertExpectations(tm))
+	require.True(t, tm.helper)
+	require.Equal(t, []string{
+		`Expected call Get(mock.Anything, "a") was not done`,
+		`Expected call Get(mock.Anything, "b") 2 times, called 1 times`,
+	}, tm.errors)
+}
+
+func TestMockRun(t *testing.T) {
+	d := &datastoreMock{}
+
+	var stored []byte
+	d.On("Put", mock.Anything, "a", mock.MatchedBy(func(b []byte) bool { return b == nil || len(b) > 0 })).
+		Run(func(args mock.Arguments) { stored = args.Get(2).([]byte) }).
+		Return(nil)
+
+	require.NoError(t, d.Put(t.Context(), "a", []byte("data")))
+	require.Equal(t, []byte("data"), stored)
+
+	require.NoError(t, d.Put(t.Context(), "a", nil))
+	require.Nil(t, stored)
+
+	require.Panics(t, func() { d.Put(t.Context(), "a", []byte{}) })
+}
+
+func TestArgumentMatcher(t *testing.T) {
+	m := mock.MatchedBy(func(b []byte) bool { return len(b) == 0 })
+	require.True(t, m.Matches([]byte{}))
+	require.True(t, m.Matches(nil))
+	require.False(t, m.Matches([]byte{1}))
+	require.False(t, m.Matches("text"))
+	require.Equal(t, "MatchedBy([]uint8)", m.String())
+
+	m = mock.MatchedBy(func(i int) bool { return i > 0 })
+	require.False(t, m.Matches(nil))
+	require.True(t, m.Matches(1))
+}
+
+func TestMockMethodCalled(t *testing.T) {
+	m := &mock.Mock{}
+	m.On("Sum", 1, 2).Return(3, "three", true)
+
+	args := m.MethodCalled("Sum", 1, 2)
+	require.Equal(t, 3, args.Int(0))
+	require.Equal(t, "three", args.String(1))
+	require.True(t, args.Bool(2))
+
+	require.PanicsWithValue(t, `mock: unexpected call Sum(1, 2)`, func() { m.MethodCalled("Sum", 1, int64(2)) })
+	require.PanicsWithValue(t, `mock: unexpected call Sum(1)`, func() { m.MethodCalled("Sum", 1) })
+
+	m.On("Inc", mock.Anything).Return().Once()
+	m.MethodCalled("Inc", 1)
+	require.PanicsWithValue(t, `mock: Inc(2) called more than expected 1 times`, func() { m.MethodCalled("Inc", 2) })
+}
+
+func TestMockConcurrentCalls(t *testing.T) {
+	d := &datastoreMock{}
+	d.On("Get", mock.Anything, mock.Anything).Return([]byte("data"), nil).Times(100)
+
+	wg := sync.WaitGroup{}
+	for range 100 {
+		wg.Go(func() {
+			_, err := d.Get(t.Context(), "key")
+			require.NoError(t, err)
+		})
+	}
+	wg.Wait()
+
+	require.True(t, d.AssertExpectations(t))
+}
@@ -0,0 +1,421 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package require
+
+import (
+	"time"
+
+	"github.com/cinode/go-common/picotestify/assert"
+)
+
+// Assertions provides assertion methods bound to a single TestingT,
+// see assert.Assertions for details about methods of generic assertions
+type Assertions struct {
+	t      TestingT
+	assert *assert.Assertions
+}
+
+func New(t TestingT) *Assertions { return &Assertions{t: t, assert: assert.New(t)} }
+
+func (a *Assertions) True(condition bool, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.True(condition, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) False(condition bool, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.False(condition, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Nil(object any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Nil(object, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) NotNil(object any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.NotNil(object, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) NoError(err error, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.NoError(err, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) ErrorIs(err, target error, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.ErrorIs(err, target, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) ErrorContains(err error, contains string, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.ErrorContains(err, contains, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Error(err error, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Error(err, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) EqualError(err error, errString string, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.EqualError(err, errString, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) NotErrorIs(err, target error, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.NotErrorIs(err, target, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) ErrorIsAll(err error, targets []error, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.ErrorIsAll(err, targets, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Empty(object any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Empty(object, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) NotEmpty(object any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.NotEmpty(object, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Zero(value any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Zero(value, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) NotZero(value any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.NotZero(value, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Panics(f func(), msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Panics(f, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) NotPanics(f func(), msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.NotPanics(f, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) PanicsWithValue(expected any, f func(), msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.PanicsWithValue(expected, f, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) PanicsWithError(errString string, f func(), msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.PanicsWithError(errString, f, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Regexp(pattern, text string, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Regexp(pattern, text, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Len(obj any, length int, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Len(obj, length, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Contains(s any, contains any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Contains(s, contains, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) NotContains(s any, contains any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.NotContains(s, contains, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) ContainsString(s, substr string, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.ContainsString(s, substr, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) NotContainsString(s, substr string, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.NotContainsString(s, substr, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) ContainsKey(m any, key any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.ContainsKey(m, key, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) NotContainsKey(m any, key any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.NotContainsKey(m, key, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.WithinDuration(expected, actual, delta, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) WithinRange(actual, start, end time.Time, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.WithinRange(actual, start, end, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Eventually(condition func() bool, waitFor, tick time.Duration, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Eventually(condition, waitFor, tick, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Never(condition func() bool, waitFor, tick time.Duration, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Never(condition, waitFor, tick, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) EventuallyWithT(
+	condition func(collect *assert.CollectT), waitFor, tick time.Duration, msgAndArgs ...any,
+) {
+	a.t.Helper()
+	if !a.assert.EventuallyWithT(condition, waitFor, tick, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Equal(expected, actual any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Equal(expected, actual, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) NotEqual(expected, actual any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.NotEqual(expected, actual, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) ErrorAs(err error, target any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.ErrorAs(err, target, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Greater(e1, e2 any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Greater(e1, e2, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) GreaterOrEqual(e1, e2 any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.GreaterOrEqual(e1, e2, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Less(e1, e2 any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Less(e1, e2, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) LessOrEqual(e1, e2 any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.LessOrEqual(e1, e2, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Positive(e any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Positive(e, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Negative(e any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Negative(e, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) InDelta(expected, actual any, delta float64, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.InDelta(expected, actual, delta, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) InDeltaSlice(expected, actual any, delta float64, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.InDeltaSlice(expected, actual, delta, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) InEpsilon(expected, actual any, epsilon float64, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.InEpsilon(expected, actual, epsilon, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) ElementsMatch(listA, listB any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.ElementsMatch(listA, listB, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Subset(list, subset any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Subset(list, subset, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) NotSubset(list, subset any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.NotSubset(list, subset, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) PanicValue(f func(), msgAndArgs ...any) any {
+	a.t.Helper()
+	value, ok := a.assert.PanicValue(f, msgAndArgs...)
+	if !ok {
+		a.t.FailNow()
+	}
+	return value
+}
+
+func (a *Assertions) Exactly(expected, actual any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Exactly(expected, actual, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) IsType(expectedType, object any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.IsType(expectedType, object, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Implements(interfaceObject, object any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Implements(interfaceObject, object, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) Same(expected, actual any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.Same(expected, actual, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) NotSame(expected, actual any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.NotSame(expected, actual, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) EqualValues(expected, actual any, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.EqualValues(expected, actual, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
+
+func (a *Assertions) JSONEq(expected, actual string, msgAndArgs ...any) {
+	a.t.Helper()
+	if !a.assert.JSONEq(expected, actual, msgAndArgs...) {
+		a.t.FailNow()
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package require_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cinode/go-common/picotestify/assert"
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+func TestAssertions(t *testing.T) {
+	var testError = errors.New("test-error")
+
+	for _, tt := range []struct {
+		pass func(r *require.Assertions)
+		fail func(r *require.Assertions)
+		name string
+	}{
+		{
+			name: "Equal",
+			pass: func(r *require.Assertions) { r.Equal([]int{1}, []int{1}) },
+			fail: func(r *require.Assertions) { r.Equal(1, 2) },
+		},
+		{
+			name: "ErrorIs",
+			pass: func(r *require.Assertions) { r.ErrorIs(testError, testError) },
+			fail: func(r *require.Assertions) { r.ErrorIs(nil, testError) },
+		},
+		{
+			name: "Contains",
+			pass: func(r *require.Assertions) { r.Contains([]int{1, 2}, 2) },
+			fail: func(r *require.Assertions) { r.Contains([]int{1, 2}, 3) },
+		},
+		{
+			name: "ContainsString",
+			pass: func(r *require.Assertions) { r.ContainsString("test", "es") },
+			fail: func(r *require.Assertions) { r.ContainsString("test", "x") },
+		},
+		{
+			name: "ContainsKey",
+			pass: func(r *require.Assertions) { r.ContainsKey(map[string]int{"a": 1}, "a") },
+			fail: func(r *require.Assertions) { r.ContainsKey(map[string]int{"a": 1}, "b") },
+		},
+		{
+			name: "GreaterOrEqual",
+			pass: func(r *require.Assertions) { r.GreaterOrEqual(2, 2) },
+			fail: func(r *require.Assertions) { r.GreaterOrEqual(1, 2) },
+		},
+		{
+			name: "InDelta",
+			pass: func(r *require.Assertions) { r.InDelta(1, 1.1, 0.2) },
+			fail: func(r *require.Assertions) { r.InDelta(1, 1.5, 0.2) },
+		},
+		{
+			name: "ElementsMatch",
+			pass: func(r *require.Assertions) { r.ElementsMatch([]int{1, 2}, []int{2, 1}) },
+			fail: func(r *require.Assertions) { r.ElementsMatch([]int{1, 2}, []int{2}) },
+		},
+		{
+			name: "PanicValue",
+			pass: func(r *require.Assertions) { r.PanicValue(func() { panic(1) }) },
+			fail: func(r *require.Assertions) { r.PanicValue(func() {}) },
+		},
+		{
+			name: "EventuallyWithT",
+			pass: func(r *require.Assertions) {
+				r.EventuallyWithT(func(c *assert.CollectT) {}, time.Second, time.Millisecond)
+			},
+			fail: func(r *require.Assertions) {
+				r.EventuallyWithT(func(c *assert.CollectT) { c.FailNow() }, 10*time.Millisecond, time.Millisecond)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Run("pass", func(t *testing.T) {
+				hlp := &testingMock{}
+				tt.pass(require.New(hlp))
+				require.True(t, hlp.helperCalled)
+				require.False(t, hlp.errorCalled)
+				require.False(t, hlp.failNowCalled)
+			})
+			t.Run("fail", func(t *testing.T) {
+				hlp := &testingMock{}
+				tt.fail(require.New(hlp))
+				require.True(t, hlp.helperCalled)
+				require.True(t, hlp.errorCalled)
+				require.True(t, hlp.failNowCalled)
+			})
+		})
+	}
+}
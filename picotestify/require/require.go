@@ -17,6 +17,8 @@ limitations under the License.
 package require
 
 import (
+	"time"
+
 	"github.com/cinode/go-common/picotestify/assert"
 	"golang.org/x/exp/constraints"
 )
@@ -103,6 +105,41 @@ func ErrorContains(t TestingT, err error, contains string, msgAndArgs ...any) {
 	}
 }
 
+func Error(t TestingT, err error, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.Error(t, err, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func EqualError(t TestingT, err error, errString string, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.EqualError(t, err, errString, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func NotErrorIs(t TestingT, err, target error, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.NotErrorIs(t, err, target, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func ErrorAs[T any](t TestingT, err error, target *T, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.ErrorAs(t, err, target, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func ErrorIsAll(t TestingT, err error, targets []error, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.ErrorIsAll(t, err, targets, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
 func Empty(t TestingT, object any, msgAndArgs ...any) {
 	t.Helper()
 	if !assert.Empty(t, object, msgAndArgs...) {
@@ -145,6 +182,29 @@ func NotPanics(t TestingT, f func(), msgAndArgs ...any) {
 	}
 }
 
+func PanicValue(t TestingT, f func(), msgAndArgs ...any) any {
+	t.Helper()
+	value, ok := assert.PanicValue(t, f, msgAndArgs...)
+	if !ok {
+		t.FailNow()
+	}
+	return value
+}
+
+func PanicsWithValue(t TestingT, expected any, f func(), msgAndArgs ...any) {
+	t.Helper()
+	if !assert.PanicsWithValue(t, expected, f, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func PanicsWithError(t TestingT, errString string, f func(), msgAndArgs ...any) {
+	t.Helper()
+	if !assert.PanicsWithError(t, errString, f, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
 func Regexp(t TestingT, pattern, text string, msgAndArgs ...any) {
 	t.Helper()
 	if !assert.Regexp(t, pattern, text, msgAndArgs...) {
@@ -158,3 +218,207 @@ func Len(t TestingT, obj any, length int, msgAndArgs ...any) {
 		t.FailNow()
 	}
 }
+
+func Contains[S ~[]E, E comparable](t TestingT, s S, contains E, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.Contains(t, s, contains, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func NotContains[S ~[]E, E comparable](t TestingT, s S, contains E, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.NotContains(t, s, contains, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func ContainsString(t TestingT, s, substr string, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.ContainsString(t, s, substr, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func NotContainsString(t TestingT, s, substr string, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.NotContainsString(t, s, substr, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func ContainsKey[M ~map[K]V, K comparable, V any](t TestingT, m M, key K, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.ContainsKey(t, m, key, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func NotContainsKey[M ~map[K]V, K comparable, V any](t TestingT, m M, key K, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.NotContainsKey(t, m, key, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func ElementsMatch[S ~[]E, E any](t TestingT, listA, listB S, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.ElementsMatch(t, listA, listB, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func Subset[S ~[]E, E any](t TestingT, list, subset S, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.Subset(t, list, subset, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func NotSubset[S ~[]E, E any](t TestingT, list, subset S, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.NotSubset(t, list, subset, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func Eventually(t TestingT, condition func() bool, waitFor, tick time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.Eventually(t, condition, waitFor, tick, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func Never(t TestingT, condition func() bool, waitFor, tick time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.Never(t, condition, waitFor, tick, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func EventuallyWithT(
+	t TestingT, condition func(collect *assert.CollectT), waitFor, tick time.Duration, msgAndArgs ...any,
+) {
+	t.Helper()
+	if !assert.EventuallyWithT(t, condition, waitFor, tick, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func Less[T constraints.Ordered](t TestingT, a, b T, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.Less(t, a, b, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func LessOrEqual[T constraints.Ordered](t TestingT, a, b T, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.LessOrEqual(t, a, b, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func Positive[T constraints.Integer | constraints.Float](t TestingT, e T, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.Positive(t, e, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func Negative[T constraints.Integer | constraints.Float](t TestingT, e T, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.Negative(t, e, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func InDelta[T constraints.Integer | constraints.Float](
+	t TestingT, expected, actual T, delta float64, msgAndArgs ...any,
+) {
+	t.Helper()
+	if !assert.InDelta(t, expected, actual, delta, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func InDeltaSlice[S ~[]T, T constraints.Integer | constraints.Float](
+	t TestingT, expected, actual S, delta float64, msgAndArgs ...any,
+) {
+	t.Helper()
+	if !assert.InDeltaSlice(t, expected, actual, delta, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func InEpsilon[T constraints.Integer | constraints.Float](
+	t TestingT, expected, actual T, epsilon float64, msgAndArgs ...any,
+) {
+	t.Helper()
+	if !assert.InEpsilon(t, expected, actual, epsilon, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func WithinDuration(t TestingT, expected, actual time.Time, delta time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.WithinDuration(t, expected, actual, delta, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func WithinRange(t TestingT, actual, start, end time.Time, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.WithinRange(t, actual, start, end, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func Exactly(t TestingT, expected, actual any, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.Exactly(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func IsType(t TestingT, expectedType, object any, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.IsType(t, expectedType, object, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func Implements[I any](t TestingT, object any, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.Implements[I](t, object, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func Same[T any](t TestingT, expected, actual *T, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.Same(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func NotSame[T any](t TestingT, expected, actual *T, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.NotSame(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func EqualValues(t TestingT, expected, actual any, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.EqualValues(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+func JSONEq(t TestingT, expected, actual string, msgAndArgs ...any) {
+	t.Helper()
+	if !assert.JSONEq(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
@@ -17,13 +17,23 @@ limitations under the License.
 package require_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"testing"
+	"time"
 
+	"github.com/cinode/go-common/picotestify/assert"
 	"github.com/cinode/go-common/picotestify/require"
 )
 
+type testTypedError struct{ code int }
+
+func (e *testTypedError) Error() string { return fmt.Sprintf("typed error %d", e.code) }
+func (e *testTypedError) Code() int     { return e.code }
+
 type testingMock struct {
 	helperCalled  bool
 	errorCalled   bool
@@ -155,6 +165,363 @@ func TestRequire(t *testing.T) {
 			pass: func(t require.TestingT) { require.Len(t, []int{1, 2, 3}, 3) },
 			fail: func(t require.TestingT) { require.Len(t, []int{1, 2, 3}, 2) },
 		},
+		{
+			name: "Len - map",
+			pass: func(t require.TestingT) { require.Len(t, map[int]int{1: 1}, 1) },
+			fail: func(t require.TestingT) { require.Len(t, map[int]int{1: 1}, 2) },
+		},
+		{
+			name: "Len - channel",
+			pass: func(t require.TestingT) { require.Len(t, make(chan int), 0) },
+			fail: func(t require.TestingT) { require.Len(t, 1, 0) },
+		},
+		{
+			name: "Empty - channel",
+			pass: func(t require.TestingT) { require.Empty(t, make(chan int, 1)) },
+			fail: func(t require.TestingT) {
+				ch := make(chan int, 1)
+				ch <- 1
+				require.Empty(t, ch)
+			},
+		},
+		{
+			name: "Contains",
+			pass: func(t require.TestingT) { require.Contains(t, []int{1, 2, 3}, 2) },
+			fail: func(t require.TestingT) { require.Contains(t, []int{1, 2, 3}, 4) },
+		},
+		{
+			name: "Contains - nil slice",
+			pass: func(t require.TestingT) { require.NotContains(t, []string(nil), "a") },
+			fail: func(t require.TestingT) { require.Contains(t, []string(nil), "a") },
+		},
+		{
+			name: "NotContains",
+			pass: func(t require.TestingT) { require.NotContains(t, []string{"a", "b"}, "c") },
+			fail: func(t require.TestingT) { require.NotContains(t, []string{"a", "b"}, "b") },
+		},
+		{
+			name: "ContainsString",
+			pass: func(t require.TestingT) { require.ContainsString(t, "test", "es") },
+			fail: func(t require.TestingT) { require.ContainsString(t, "test", "se") },
+		},
+		{
+			name: "NotContainsString",
+			pass: func(t require.TestingT) { require.NotContainsString(t, "test", "x") },
+			fail: func(t require.TestingT) { require.NotContainsString(t, "test", "es") },
+		},
+		{
+			name: "ContainsKey",
+			pass: func(t require.TestingT) { require.ContainsKey(t, map[string]int{"a": 1}, "a") },
+			fail: func(t require.TestingT) { require.ContainsKey(t, map[string]int{"a": 1}, "b") },
+		},
+		{
+			name: "NotContainsKey",
+			pass: func(t require.TestingT) { require.NotContainsKey(t, map[string]int{"a": 1}, "b") },
+			fail: func(t require.TestingT) { require.NotContainsKey(t, map[string]int{"a": 1}, "a") },
+		},
+		{
+			name: "ElementsMatch",
+			pass: func(t require.TestingT) { require.ElementsMatch(t, []int{1, 2, 2, 3}, []int{2, 3, 1, 2}) },
+			fail: func(t require.TestingT) { require.ElementsMatch(t, []int{1, 2, 2}, []int{1, 1, 2}) },
+		},
+		{
+			name: "Subset",
+			pass: func(t require.TestingT) { require.Subset(t, []int{1, 2, 3}, []int{3, 1, 1}) },
+			fail: func(t require.TestingT) { require.Subset(t, []int{1, 2, 3}, []int{1, 4}) },
+		},
+		{
+			name: "NotSubset",
+			pass: func(t require.TestingT) { require.NotSubset(t, []int{1, 2, 3}, []int{1, 4}) },
+			fail: func(t require.TestingT) { require.NotSubset(t, []int{1, 2, 3}, []int{3, 2}) },
+		},
+		{
+			name: "Error",
+			pass: func(t require.TestingT) { require.Error(t, testError) },
+			fail: func(t require.TestingT) { require.Error(t, nil) },
+		},
+		{
+			name: "EqualError",
+			pass: func(t require.TestingT) { require.EqualError(t, testError, "test-error") },
+			fail: func(t require.TestingT) { require.EqualError(t, testError, "test") },
+		},
+		{
+			name: "EqualError - nil",
+			pass: func(t require.TestingT) { require.EqualError(t, fmt.Errorf("a: %w", testError), "a: test-error") },
+			fail: func(t require.TestingT) { require.EqualError(t, nil, "test-error") },
+		},
+		{
+			name: "NotErrorIs",
+			pass: func(t require.TestingT) { require.NotErrorIs(t, testError, errors.New("error2")) },
+			fail: func(t require.TestingT) { require.NotErrorIs(t, fmt.Errorf("error: %w", testError), testError) },
+		},
+		{
+			name: "ErrorAs",
+			pass: func(t require.TestingT) {
+				var target *testTypedError
+				require.ErrorAs(t, fmt.Errorf("error: %w", &testTypedError{}), &target)
+			},
+			fail: func(t require.TestingT) {
+				var target *testTypedError
+				require.ErrorAs(t, testError, &target)
+			},
+		},
+		{
+			name: "ErrorAs - invalid target",
+			pass: func(t require.TestingT) {
+				var target interface{ Code() int }
+				require.ErrorAs(t, &testTypedError{}, &target)
+			},
+			fail: func(t require.TestingT) {
+				var target testTypedError
+				require.ErrorAs(t, &testTypedError{}, &target)
+			},
+		},
+		{
+			name: "ErrorIsAll",
+			pass: func(t require.TestingT) {
+				err2 := errors.New("error2")
+				require.ErrorIsAll(t, errors.Join(testError, err2), []error{err2, testError})
+			},
+			fail: func(t require.TestingT) {
+				require.ErrorIsAll(t, errors.Join(testError), []error{testError, errors.New("error2")})
+			},
+		},
+		{
+			name: "Eventually",
+			pass: func(t require.TestingT) {
+				calls := 0
+				require.Eventually(t, func() bool { calls++; return calls == 3 }, time.Second, time.Millisecond)
+			},
+			fail: func(t require.TestingT) {
+				require.Eventually(t, func() bool { return false }, 20*time.Millisecond, time.Millisecond)
+			},
+		},
+		{
+			name: "Never",
+			pass: func(t require.TestingT) {
+				require.Never(t, func() bool { return false }, 20*time.Millisecond, time.Millisecond)
+			},
+			fail: func(t require.TestingT) {
+				calls := 0
+				require.Never(t, func() bool { calls++; return calls == 3 }, time.Second, time.Millisecond)
+			},
+		},
+		{
+			name: "EventuallyWithT",
+			pass: func(t require.TestingT) {
+				calls := 0
+				require.EventuallyWithT(t, func(c *assert.CollectT) {
+					calls++
+					require.Greater(c, calls, 2)
+					assert.Equal(c, 3, calls)
+				}, time.Second, time.Millisecond)
+			},
+			fail: func(t require.TestingT) {
+				require.EventuallyWithT(t, func(c *assert.CollectT) {
+					assert.True(c, false)
+				}, 20*time.Millisecond, time.Millisecond)
+			},
+		},
+		{
+			name: "Less",
+			pass: func(t require.TestingT) { require.Less(t, 1, 2) },
+			fail: func(t require.TestingT) { require.Less(t, 2, 2) },
+		},
+		{
+			name: "LessOrEqual",
+			pass: func(t require.TestingT) { require.LessOrEqual(t, "a", "a") },
+			fail: func(t require.TestingT) { require.LessOrEqual(t, "b", "a") },
+		},
+		{
+			name: "Positive",
+			pass: func(t require.TestingT) { require.Positive(t, 0.1) },
+			fail: func(t require.TestingT) { require.Positive(t, 0) },
+		},
+		{
+			name: "Negative",
+			pass: func(t require.TestingT) { require.Negative(t, int8(-1)) },
+			fail: func(t require.TestingT) { require.Negative(t, 0.0) },
+		},
+		{
+			name: "InDelta",
+			pass: func(t require.TestingT) { require.InDelta(t, 1.0, 1.05, 0.1) },
+			fail: func(t require.TestingT) { require.InDelta(t, 10, 12, 1) },
+		},
+		{
+			name: "InDelta - NaN",
+			pass: func(t require.TestingT) { require.InDelta(t, math.NaN(), math.NaN(), 0.1) },
+			fail: func(t require.TestingT) { require.InDelta(t, math.NaN(), 1, 0.1) },
+		},
+		{
+			name: "InDeltaSlice",
+			pass: func(t require.TestingT) { require.InDeltaSlice(t, []float64{1, 2}, []float64{1.01, 1.99}, 0.1) },
+			fail: func(t require.TestingT) { require.InDeltaSlice(t, []float64{1, 2}, []float64{1.01, 2.2}, 0.1) },
+		},
+		{
+			name: "InDeltaSlice - length",
+			pass: func(t require.TestingT) { require.InDeltaSlice(t, []int{}, nil, 0) },
+			fail: func(t require.TestingT) { require.InDeltaSlice(t, []int{1}, []int{1, 2}, 1) },
+		},
+		{
+			name: "InEpsilon",
+			pass: func(t require.TestingT) { require.InEpsilon(t, 100, 101, 0.02) },
+			fail: func(t require.TestingT) { require.InEpsilon(t, 100, 110, 0.02) },
+		},
+		{
+			name: "InEpsilon - zero",
+			pass: func(t require.TestingT) { require.InEpsilon(t, -1.0, -1.0, 0) },
+			fail: func(t require.TestingT) { require.InEpsilon(t, 0, 0, 1) },
+		},
+		{
+			name: "WithinDuration",
+			pass: func(t require.TestingT) {
+				require.WithinDuration(t, time.Unix(100, 0), time.Unix(99, 0), time.Second)
+			},
+			fail: func(t require.TestingT) {
+				require.WithinDuration(t, time.Unix(100, 0), time.Unix(102, 0), time.Second)
+			},
+		},
+		{
+			name: "WithinRange",
+			pass: func(t require.TestingT) {
+				require.WithinRange(t, time.Unix(100, 0), time.Unix(100, 0), time.Unix(101, 0))
+			},
+			fail: func(t require.TestingT) {
+				require.WithinRange(t, time.Unix(102, 0), time.Unix(100, 0), time.Unix(101, 0))
+			},
+		},
+		{
+			name: "WithinRange - invalid range",
+			pass: func(t require.TestingT) {
+				require.WithinRange(t, time.Unix(101, 0), time.Unix(100, 0), time.Unix(101, 0))
+			},
+			fail: func(t require.TestingT) {
+				require.WithinRange(t, time.Unix(100, 0), time.Unix(101, 0), time.Unix(99, 0))
+			},
+		},
+		{
+			name: "NotPanics - nil panic",
+			pass: func(t require.TestingT) { require.NotPanics(t, func() {}) },
+			fail: func(t require.TestingT) { require.NotPanics(t, func() { panic(nil) }) },
+		},
+		{
+			name: "PanicValue",
+			pass: func(t require.TestingT) { require.PanicValue(t, func() { panic(testError) }) },
+			fail: func(t require.TestingT) { require.PanicValue(t, func() {}) },
+		},
+		{
+			name: "PanicsWithValue",
+			pass: func(t require.TestingT) { require.PanicsWithValue(t, []int{1, 2}, func() { panic([]int{1, 2}) }) },
+			fail: func(t require.TestingT) { require.PanicsWithValue(t, []int{1, 2}, func() { panic([]int{1}) }) },
+		},
+		{
+			name: "PanicsWithValue - no panic",
+			pass: func(t require.TestingT) { require.PanicsWithValue(t, "test", func() { panic("test") }) },
+			fail: func(t require.TestingT) { require.PanicsWithValue(t, "test", func() {}) },
+		},
+		{
+			name: "PanicsWithValue - nil",
+			pass: func(t require.TestingT) { require.PanicsWithValue(t, nil, func() { panic(nil) }) },
+			fail: func(t require.TestingT) { require.PanicsWithValue(t, nil, func() { panic(0) }) },
+		},
+		{
+			name: "PanicsWithError",
+			pass: func(t require.TestingT) { require.PanicsWithError(t, "test-error", func() { panic(testError) }) },
+			fail: func(t require.TestingT) { require.PanicsWithError(t, "test", func() { panic(testError) }) },
+		},
+		{
+			name: "PanicsWithError - not an error",
+			pass: func(t require.TestingT) {
+				require.PanicsWithError(t, "typed error 1", func() { panic(&testTypedError{code: 1}) })
+			},
+			fail: func(t require.TestingT) { require.PanicsWithError(t, "test-error", func() { panic("test-error") }) },
+		},
+		{
+			name: "PanicsWithError - no panic",
+			pass: func(t require.TestingT) { require.PanicsWithError(t, "test-error", func() { panic(testError) }) },
+			fail: func(t require.TestingT) { require.PanicsWithError(t, "test-error", func() {}) },
+		},
+		{
+			name: "Exactly",
+			pass: func(t require.TestingT) { require.Exactly(t, int32(1), int32(1)) },
+			fail: func(t require.TestingT) { require.Exactly(t, int32(1), int64(1)) },
+		},
+		{
+			name: "Exactly - values",
+			pass: func(t require.TestingT) { require.Exactly(t, []int{1}, []int{1}) },
+			fail: func(t require.TestingT) { require.Exactly(t, []int{1}, []int{2}) },
+		},
+		{
+			name: "IsType",
+			pass: func(t require.TestingT) { require.IsType(t, &testTypedError{}, &testTypedError{code: 1}) },
+			fail: func(t require.TestingT) { require.IsType(t, &testTypedError{}, testTypedError{}) },
+		},
+		{
+			name: "Implements",
+			pass: func(t require.TestingT) { require.Implements[error](t, &testTypedError{}) },
+			fail: func(t require.TestingT) { require.Implements[error](t, testTypedError{}) },
+		},
+		{
+			name: "Implements - not an interface",
+			pass: func(t require.TestingT) { require.Implements[fmt.Stringer](t, time.Second) },
+			fail: func(t require.TestingT) { require.Implements[testTypedError](t, testTypedError{}) },
+		},
+		{
+			name: "Same",
+			pass: func(t require.TestingT) {
+				v := 1
+				require.Same(t, &v, &v)
+			},
+			fail: func(t require.TestingT) {
+				v1, v2 := 1, 1
+				require.Same(t, &v1, &v2)
+			},
+		},
+		{
+			name: "NotSame",
+			pass: func(t require.TestingT) {
+				b := []byte{1, 2}
+				require.NotSame(t, &b[0], &bytes.Clone(b)[0])
+			},
+			fail: func(t require.TestingT) {
+				b := []byte{1, 2}
+				require.NotSame(t, &b[0], &b[:1][0])
+			},
+		},
+		{
+			name: "EqualValues",
+			pass: func(t require.TestingT) { require.EqualValues(t, int32(1), int64(1)) },
+			fail: func(t require.TestingT) { require.EqualValues(t, 1.5, 1) },
+		},
+		{
+			name: "EqualValues - named types",
+			pass: func(t require.TestingT) { require.EqualValues(t, time.Duration(5), 5) },
+			fail: func(t require.TestingT) { require.EqualValues(t, "A", 65) },
+		},
+		{
+			name: "EqualValues - slices",
+			pass: func(t require.TestingT) { require.EqualValues(t, []byte("ab"), json.RawMessage("ab")) },
+			fail: func(t require.TestingT) { require.EqualValues(t, []byte("ab"), nil) },
+		},
+		{
+			name: "JSONEq",
+			pass: func(t require.TestingT) {
+				require.JSONEq(t, `{"a": 1, "b": [true, null]}`, `{"b":[true,null],"a":1.0}`)
+			},
+			fail: func(t require.TestingT) {
+				require.JSONEq(t, `{"a": 1, "b": [true, null]}`, `{"a": 1, "b": [null, true]}`)
+			},
+		},
+		{
+			name: "JSONEq - invalid",
+			pass: func(t require.TestingT) { require.JSONEq(t, `"a"`, ` "a" `) },
+			fail: func(t require.TestingT) { require.JSONEq(t, `{"a": 1}`, `{"a": 1`) },
+		},
+		{
+			name: "JSONEq - invalid expected",
+			pass: func(t require.TestingT) { require.JSONEq(t, `[]`, `[]`) },
+			fail: func(t require.TestingT) { require.JSONEq(t, `{`, `{}`) },
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Run("pass", func(t *testing.T) {
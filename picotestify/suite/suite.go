@@ -20,10 +20,20 @@ import (
 	"reflect"
 	"regexp"
 	"testing"
+
+	"github.com/cinode/go-common/picotestify/assert"
+	"github.com/cinode/go-common/picotestify/require"
 )
 
+// Suite is the base for test suites
+//
+// Same as in testify, non-fatal assertions are available directly on the suite,
+// fatal ones through s.Require(). Assertions are bound to the current test.
 type Suite struct {
-	t *testing.T
+	*assert.Assertions
+
+	require *require.Assertions
+	t       *testing.T
 }
 
 type suiteInterface interface {
@@ -31,8 +41,15 @@ type suiteInterface interface {
 	SetT(t *testing.T)
 }
 
-func (s *Suite) T() *testing.T     { return s.t }
-func (s *Suite) SetT(t *testing.T) { s.t = t }
+func (s *Suite) T() *testing.T                { return s.t }
+func (s *Suite) Assert() *assert.Assertions   { return s.Assertions }
+func (s *Suite) Require() *require.Assertions { return s.require }
+
+func (s *Suite) SetT(t *testing.T) {
+	s.t = t
+	s.Assertions = assert.New(t)
+	s.require = require.New(t)
+}
 
 var testMethodRe = regexp.MustCompile("^Test")
 
@@ -41,10 +41,18 @@ func (s *sampleSuite) TearDownSuite() { s.tearDownSuiteCalls++ }
 func (s *sampleSuite) SetupTest()     { s.setupTestCalls++ }
 func (s *sampleSuite) TearDownTest()  { s.tearDownTestCalls++ }
 
-func (s *sampleSuite) Test1()     { s.test1T = s.T() }
 func (s *sampleSuite) NotATest2() { s.test2T = s.T() }
 func (s *sampleSuite) Test3()     { s.test3T = s.T() }
 
+func (s *sampleSuite) Test1() {
+	s.test1T = s.T()
+
+	s.Equal(1, 1)
+	s.Greater(2, 1)
+	s.Assert().NotEmpty("test")
+	s.Require().ElementsMatch([]int{1, 2}, []int{2, 1})
+}
+
 func TestSuite(t *testing.T) {
 	s := sampleSuite{}
 
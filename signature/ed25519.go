@@ -0,0 +1,90 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"github.com/cinode/go-common/cutl"
+)
+
+const Ed25519ID SchemeID = 0x01
+
+// Ed25519 is the ed25519 signature scheme, the private key is the 32-byte ed25519 seed
+var Ed25519 Scheme = ed25519Scheme{}
+
+func init() {
+	cutl.PanicIfError(Register(Ed25519))
+}
+
+type ed25519Scheme struct{}
+
+func (ed25519Scheme) ID() SchemeID        { return Ed25519ID }
+func (ed25519Scheme) Name() string        { return "ed25519" }
+func (ed25519Scheme) PublicKeySize() int  { return ed25519.PublicKeySize }
+func (ed25519Scheme) PrivateKeySize() int { return ed25519.SeedSize }
+func (ed25519Scheme) SignatureSize() int  { return ed25519.SignatureSize }
+
+func (ed25519Scheme) GenerateKey(rnd io.Reader) ([]byte, error) {
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := io.ReadFull(rnd, seed); err != nil {
+		return nil, fmt.Errorf("failed to read random data: %w", err)
+	}
+	return seed, nil
+}
+
+func (ed25519Scheme) privateKey(seed []byte) (ed25519.PrivateKey, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, ErrInvalidPrivateKey
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+func (s ed25519Scheme) PublicKey(privateKey []byte) ([]byte, error) {
+	priv, err := s.privateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(priv)
+
+	return bytes.Clone(priv.Public().(ed25519.PublicKey)), nil
+}
+
+func (s ed25519Scheme) Sign(privateKey []byte, message []byte) ([]byte, error) {
+	priv, err := s.privateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(priv)
+
+	return ed25519.Sign(priv, message), nil
+}
+
+func (ed25519Scheme) Verify(publicKey []byte, message []byte, signature []byte) bool {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return false
+	}
+	return ed25519.Verify(publicKey, message, signature)
+}
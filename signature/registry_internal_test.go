@@ -0,0 +1,60 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/cinode/go-common/picotestify/require"
+)
+
+type testScheme struct{ Scheme }
+
+func (testScheme) ID() SchemeID { return 0xFE }
+func (testScheme) Name() string { return "test" }
+
+// restoreSchemes brings back the registry content once the test finishes
+func restoreSchemes(t *testing.T) {
+	schemesMu.Lock()
+	saved := maps.Clone(schemes)
+	schemesMu.Unlock()
+
+	t.Cleanup(func() {
+		schemesMu.Lock()
+		schemes = saved
+		schemesMu.Unlock()
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	restoreSchemes(t)
+
+	s, err := Lookup(Ed25519ID)
+	require.NoError(t, err)
+	require.Equal(t, Ed25519, s)
+
+	_, err = Lookup(0xFE)
+	require.ErrorIs(t, err, ErrUnknownScheme)
+
+	require.ErrorIs(t, Register(Ed25519), ErrSchemeAlreadyRegistered)
+
+	require.NoError(t, Register(testScheme{Ed25519}))
+	s, err = Lookup(0xFE)
+	require.NoError(t, err)
+	require.Equal(t, "test", s.Name())
+}
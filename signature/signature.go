@@ -0,0 +1,156 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/cinode/go-common/blob"
+)
+
+var (
+	ErrUnknownScheme           = errors.New("unknown signature scheme")
+	ErrSchemeAlreadyRegistered = errors.New("signature scheme already registered")
+	ErrInvalidPublicKey        = errors.New("invalid public key")
+	ErrInvalidPrivateKey       = errors.New("invalid private key")
+	ErrInvalidAuthInfo         = errors.New("invalid signature auth info")
+)
+
+// SchemeID identifies the signature scheme in serialized public keys and auth info
+type SchemeID byte
+
+// Scheme is a signature scheme used to sign dynamic links.
+//
+// Public keys and auth infos are prefixed with the scheme ID, thus new schemes
+// (e.g. hybrid classic / post-quantum ones) can be added without changing the
+// semantics of blob names that are derived from serialized public keys.
+type Scheme interface {
+	ID() SchemeID
+	Name() string
+
+	PublicKeySize() int
+	PrivateKeySize() int
+	SignatureSize() int
+
+	// GenerateKey creates a new private key, crypto/rand.Reader is used if rnd is nil
+	GenerateKey(rnd io.Reader) (privateKey []byte, err error)
+	PublicKey(privateKey []byte) ([]byte, error)
+	Sign(privateKey []byte, message []byte) ([]byte, error)
+	Verify(publicKey []byte, message []byte, signature []byte) bool
+}
+
+var (
+	schemesMu sync.RWMutex
+	schemes   = map[SchemeID]Scheme{}
+)
+
+// Register adds a new signature scheme, registering two schemes with the same ID is an error
+func Register(s Scheme) error {
+	schemesMu.Lock()
+	defer schemesMu.Unlock()
+
+	if existing, found := schemes[s.ID()]; found {
+		return fmt.Errorf("%w: %d (%s)", ErrSchemeAlreadyRegistered, s.ID(), existing.Name())
+	}
+	schemes[s.ID()] = s
+	return nil
+}
+
+// Lookup finds registered scheme by its ID
+func Lookup(id SchemeID) (Scheme, error) {
+	schemesMu.RLock()
+	defer schemesMu.RUnlock()
+
+	s, found := schemes[id]
+	if !found {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownScheme, id)
+	}
+	return s, nil
+}
+
+// EncodePublicKey serializes public key prefixed with the scheme ID
+func EncodePublicKey(s Scheme, publicKey []byte) ([]byte, error) {
+	if len(publicKey) != s.PublicKeySize() {
+		return nil, ErrInvalidPublicKey
+	}
+	return append([]byte{byte(s.ID())}, publicKey...), nil
+}
+
+// DecodePublicKey parses public key serialized with EncodePublicKey
+func DecodePublicKey(data []byte) (Scheme, []byte, error) {
+	if len(data) == 0 {
+		return nil, nil, ErrInvalidPublicKey
+	}
+	s, err := Lookup(SchemeID(data[0]))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data)-1 != s.PublicKeySize() {
+		return nil, nil, ErrInvalidPublicKey
+	}
+	return s, data[1:], nil
+}
+
+// NewAuthInfo creates auth info containing the private key of given scheme
+func NewAuthInfo(s Scheme, privateKey []byte) (*blob.AuthInfo, error) {
+	if len(privateKey) != s.PrivateKeySize() {
+		return nil, ErrInvalidPrivateKey
+	}
+	data := append([]byte{byte(s.ID())}, privateKey...)
+	defer clear(data)
+
+	return blob.AuthInfoFromBytes(data), nil
+}
+
+// AuthInfoFromMasterSeed deterministically derives auth info of given scheme from the master seed.
+//
+// The private key is generated from PrivateKeySize bytes of the seed key material
+// specific to the scheme, thus the scheme must not need more data than that to create a key.
+func AuthInfoFromMasterSeed(s Scheme, seed *blob.MasterSeed) (*blob.AuthInfo, error) {
+	material := seed.KeyMaterial(fmt.Sprintf("signature:%d", s.ID()), s.PrivateKeySize())
+	defer clear(material)
+
+	privateKey, err := s.GenerateKey(bytes.NewReader(material))
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive private key: %w", err)
+	}
+	defer clear(privateKey)
+
+	return NewAuthInfo(s, privateKey)
+}
+
+// FromAuthInfo extracts the scheme and the private key from auth info
+func FromAuthInfo(ai *blob.AuthInfo) (Scheme, []byte, error) {
+	data := ai.Bytes()
+	if len(data) == 0 {
+		return nil, nil, ErrInvalidAuthInfo
+	}
+	s, err := Lookup(SchemeID(data[0]))
+	if err != nil {
+		clear(data)
+		return nil, nil, err
+	}
+	if len(data)-1 != s.PrivateKeySize() {
+		clear(data)
+		return nil, nil, ErrInvalidAuthInfo
+	}
+	return s, data[1:], nil
+}
@@ -0,0 +1,167 @@
+/*
+Copyright © 2025 Bartłomiej Święcki (byo)
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/cinode/go-common/blob"
+	"github.com/cinode/go-common/cutl"
+	"github.com/cinode/go-common/picotestify/require"
+	"github.com/cinode/go-common/signature"
+)
+
+func TestEd25519TestVector(t *testing.T) {
+	// RFC 8032, section 7.1, test 1
+	privateKey := cutl.Must(hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60"))
+	publicKey := cutl.Must(hex.DecodeString("d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a"))
+	sig := cutl.Must(hex.DecodeString(
+		"e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555" +
+			"fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b",
+	))
+
+	pub, err := signature.Ed25519.PublicKey(privateKey)
+	require.NoError(t, err)
+	require.Equal(t, publicKey, pub)
+
+	s, err := signature.Ed25519.Sign(privateKey, nil)
+	require.NoError(t, err)
+	require.Equal(t, sig, s)
+
+	require.True(t, signature.Ed25519.Verify(publicKey, nil, sig))
+	require.False(t, signature.Ed25519.Verify(publicKey, []byte{0}, sig))
+	require.False(t, signature.Ed25519.Verify(publicKey[1:], nil, sig))
+}
+
+func TestEd25519(t *testing.T) {
+	s := signature.Ed25519
+	require.Equal(t, signature.Ed25519ID, s.ID())
+	require.Equal(t, "ed25519", s.Name())
+
+	priv, err := s.GenerateKey(nil)
+	require.NoError(t, err)
+	require.Len(t, priv, s.PrivateKeySize())
+
+	pub, err := s.PublicKey(priv)
+	require.NoError(t, err)
+	require.Len(t, pub, s.PublicKeySize())
+
+	sig, err := s.Sign(priv, []byte("message"))
+	require.NoError(t, err)
+	require.Len(t, sig, s.SignatureSize())
+	require.True(t, s.Verify(pub, []byte("message"), sig))
+
+	entropy := bytes.Repeat([]byte{7}, s.PrivateKeySize())
+	priv, err = s.GenerateKey(bytes.NewReader(entropy))
+	require.NoError(t, err)
+	require.Equal(t, entropy, priv)
+
+	_, err = s.GenerateKey(bytes.NewReader(nil))
+	require.ErrorIs(t, err, io.EOF)
+
+	_, err = s.PublicKey([]byte{1, 2, 3})
+	require.ErrorIs(t, err, signature.ErrInvalidPrivateKey)
+
+	_, err = s.Sign([]byte{1, 2, 3}, nil)
+	require.ErrorIs(t, err, signature.ErrInvalidPrivateKey)
+}
+
+func TestPublicKeyEncoding(t *testing.T) {
+	s := signature.Ed25519
+	pub := cutl.Must(s.PublicKey(cutl.Must(s.GenerateKey(nil))))
+
+	encoded, err := signature.EncodePublicKey(s, pub)
+	require.NoError(t, err)
+	require.Equal(t, byte(signature.Ed25519ID), encoded[0])
+
+	s2, pub2, err := signature.DecodePublicKey(encoded)
+	require.NoError(t, err)
+	require.Equal(t, s, s2)
+	require.Equal(t, pub, pub2)
+
+	_, err = signature.EncodePublicKey(s, pub[1:])
+	require.ErrorIs(t, err, signature.ErrInvalidPublicKey)
+
+	for _, data := range [][]byte{nil, encoded[:10], append(encoded, 0)} {
+		_, _, err = signature.DecodePublicKey(data)
+		require.ErrorIs(t, err, signature.ErrInvalidPublicKey)
+	}
+
+	_, _, err = signature.DecodePublicKey(append([]byte{0xFF}, pub...))
+	require.ErrorIs(t, err, signature.ErrUnknownScheme)
+}
+
+func TestAuthInfo(t *testing.T) {
+	s := signature.Ed25519
+	priv := cutl.Must(s.GenerateKey(nil))
+
+	ai, err := signature.NewAuthInfo(s, priv)
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{byte(signature.Ed25519ID)}, priv...), ai.Bytes())
+
+	s2, priv2, err := signature.FromAuthInfo(ai)
+	require.NoError(t, err)
+	require.Equal(t, s, s2)
+	require.Equal(t, priv, priv2)
+
+	_, err = signature.NewAuthInfo(s, priv[1:])
+	require.ErrorIs(t, err, signature.ErrInvalidPrivateKey)
+
+	for _, data := range [][]byte{nil, {byte(signature.Ed25519ID), 1, 2}} {
+		_, _, err = signature.FromAuthInfo(blob.AuthInfoFromBytes(data))
+		require.ErrorIs(t, err, signature.ErrInvalidAuthInfo)
+	}
+
+	_, _, err = signature.FromAuthInfo(blob.AuthInfoFromBytes(append([]byte{0xFF}, priv...)))
+	require.ErrorIs(t, err, signature.ErrUnknownScheme)
+}
+
+type shortKeyScheme struct{ signature.Scheme }
+
+func (shortKeyScheme) PrivateKeySize() int { return 16 }
+
+func TestAuthInfoFromMasterSeed(t *testing.T) {
+	seed := cutl.Must(blob.MasterSeedFromBytes(cutl.Must(hex.DecodeString(
+		"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+	))))
+
+	// Key material from master_seed_test_vectors.json in the blob package
+	ai, err := signature.AuthInfoFromMasterSeed(signature.Ed25519, seed)
+	require.NoError(t, err)
+	require.Equal(t,
+		"0129795c3b4d01cc0e6aecd0f211b29e58a0dacc3fd6d0f7254d6477fff9f93eba",
+		hex.EncodeToString(ai.Bytes()),
+	)
+
+	s, priv, err := signature.FromAuthInfo(ai)
+	require.NoError(t, err)
+	require.Equal(t, signature.Ed25519, s)
+
+	pub := cutl.Must(s.PublicKey(priv))
+	sig := cutl.Must(s.Sign(priv, []byte("message")))
+	require.True(t, s.Verify(pub, []byte("message"), sig))
+
+	ai2, err := signature.AuthInfoFromMasterSeed(signature.Ed25519, seed.Child("a"))
+	require.NoError(t, err)
+	require.False(t, ai.Equal(ai2))
+
+	_, err = signature.AuthInfoFromMasterSeed(shortKeyScheme{signature.Ed25519}, seed)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}